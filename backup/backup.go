@@ -0,0 +1,228 @@
+// Package backup writes a logical backup of a Typesense cluster to a
+// zstd-compressed tar archive and restores it into another cluster.
+//
+// Unlike a server-side snapshot, the archive is portable between clusters
+// and versions. It contains:
+//
+//	backup.json                         format version and collection names
+//	collections/<name>/schema.json      collection schema
+//	collections/<name>/documents.jsonl  exported documents
+//	collections/<name>/synonyms.json
+//	collections/<name>/overrides.json
+//	aliases.json
+//	presets.json
+//	analytics_rules.json
+package backup
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/klauspost/compress/zstd"
+)
+
+const formatVersion = 1
+
+const (
+	indexFile          = "backup.json"
+	aliasesFile        = "aliases.json"
+	presetsFile        = "presets.json"
+	analyticsRulesFile = "analytics_rules.json"
+
+	schemaFile    = "schema.json"
+	documentsFile = "documents.jsonl"
+	synonymsFile  = "synonyms.json"
+	overridesFile = "overrides.json"
+)
+
+// Index is the first entry of an archive.
+type Index struct {
+	Version     int       `json:"version"`
+	CreatedAt   time.Time `json:"created_at"`
+	Collections []string  `json:"collections"`
+}
+
+// Dump writes a backup of every collection and of the cluster-wide aliases,
+// presets and analytics rules to w. Documents are exported through a
+// temporary file, so memory use does not grow with the collection size.
+// Collections are written after the collections their reference fields
+// point to, so that Restore can create them in archive order.
+func Dump(ctx context.Context, client *typesense.Client, w io.Writer) error {
+	collections, err := client.Collections.List(ctx)
+	if err != nil {
+		return err
+	}
+	collections = sortByReferences(collections)
+
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return err
+	}
+	defer zw.Close()
+	tw := tar.NewWriter(zw)
+
+	index := &Index{Version: formatVersion, CreatedAt: time.Now().UTC()}
+	for _, c := range collections {
+		index.Collections = append(index.Collections, c.Name)
+	}
+	if err := writeJSON(tw, indexFile, index); err != nil {
+		return err
+	}
+
+	for _, c := range collections {
+		if err := dumpCollection(ctx, client, tw, c.Name); err != nil {
+			return fmt.Errorf("collection %s: %w", c.Name, err)
+		}
+	}
+
+	aliases, err := client.Aliases.List(ctx)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(tw, aliasesFile, aliases); err != nil {
+		return err
+	}
+
+	presets, err := client.Presets.List(ctx)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(tw, presetsFile, presets.Presets); err != nil {
+		return err
+	}
+
+	rules, err := client.AnalyticsRules.List(ctx)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(tw, analyticsRulesFile, rules.Rules); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// sortByReferences orders collections so that each comes after the
+// collections it references, keeping the original order otherwise.
+// Collections in a reference cycle keep their original order.
+func sortByReferences(collections []*typesense.CollectionSchema) []*typesense.CollectionSchema {
+	byName := make(map[string]*typesense.CollectionSchema, len(collections))
+	for _, c := range collections {
+		byName[c.Name] = c
+	}
+
+	res := make([]*typesense.CollectionSchema, 0, len(collections))
+	done := make(map[string]bool, len(collections))
+	visiting := make(map[string]bool)
+	var visit func(c *typesense.CollectionSchema)
+	visit = func(c *typesense.CollectionSchema) {
+		if done[c.Name] || visiting[c.Name] {
+			return
+		}
+		visiting[c.Name] = true
+		for _, f := range c.Fields {
+			if f.Reference == nil {
+				continue
+			}
+			ref, _, _ := strings.Cut(*f.Reference, ".")
+			if r, ok := byName[ref]; ok && ref != c.Name {
+				visit(r)
+			}
+		}
+		visiting[c.Name] = false
+		done[c.Name] = true
+		res = append(res, c)
+	}
+	for _, c := range collections {
+		visit(c)
+	}
+	return res
+}
+
+func dumpCollection(ctx context.Context, client *typesense.Client, tw *tar.Writer, name string) error {
+	dir := path.Join("collections", name)
+
+	collection, err := client.Collections.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	schema := &typesense.CollectionSchema{
+		Name:                name,
+		DefaultSortingField: collection.DefaultSortingField,
+		EnableNestedFields:  collection.EnableNestedFields,
+		Fields:              collection.Fields,
+		SymbolsToIndex:      collection.SymbolsToIndex,
+		TokenSeparators:     collection.TokenSeparators,
+	}
+	if err := writeJSON(tw, path.Join(dir, schemaFile), schema); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp("", "typesense-backup-*.jsonl")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := client.Documents.ExportJSONL(ctx, name, f, nil); err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := writeFile(tw, path.Join(dir, documentsFile), f, size); err != nil {
+		return err
+	}
+
+	synonyms, err := client.Synonyms.List(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(tw, path.Join(dir, synonymsFile), synonyms.Synonyms); err != nil {
+		return err
+	}
+
+	overrides, err := client.Overrides.List(ctx, name)
+	if err != nil {
+		return err
+	}
+	return writeJSON(tw, path.Join(dir, overridesFile), overrides.Overrides)
+}
+
+func writeJSON(tw *tar.Writer, name string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(tw, name, strings.NewReader(string(b)), int64(len(b)))
+}
+
+func writeFile(tw *tar.Writer, name string, r io.Reader, size int64) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, r)
+	return err
+}
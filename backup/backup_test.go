@@ -0,0 +1,185 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) (*typesense.Client, *http.ServeMux) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := typesense.NewClient(nil, server.URL, "xyz")
+	require.NoError(t, err)
+	return client, mux
+}
+
+func source(t *testing.T) *typesense.Client {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/collections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name": "companies", "fields": []}, {"name": "logs", "fields": []}]`)
+	})
+	for _, name := range []string{"companies", "logs"} {
+		name := name
+		mux.HandleFunc("/collections/"+name, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"name": %q, "num_documents": 2, "created_at": 1690000000,
+				"fields": [{"name": "company_name", "type": "string"}]}`, name)
+		})
+		mux.HandleFunc("/collections/"+name+"/documents/export", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "{\"id\":\"1\",\"company_name\":\"Stark Industries\"}\n{\"id\":\"2\",\"company_name\":\"Orbit Inc.\"}")
+		})
+		mux.HandleFunc("/collections/"+name+"/synonyms", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"synonyms": [{"id": "inc", "synonyms": ["inc", "incorporated"]}]}`)
+		})
+		mux.HandleFunc("/collections/"+name+"/overrides", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"overrides": [{"id": "pin-stark", "rule": {"query": "stark", "match": "exact"}, "includes": [{"id": "1", "position": 1}]}]}`)
+		})
+	}
+	mux.HandleFunc("/aliases", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"aliases": [{"name": "firms", "collection_name": "companies"}, {"name": "events", "collection_name": "logs"}]}`)
+	})
+	mux.HandleFunc("/presets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"presets": [{"name": "listing_view", "value": {"q": "*", "sort_by": "popularity"}}]}`)
+	})
+	mux.HandleFunc("/analytics/rules", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rules": [{"name": "company_queries", "type": "popular_queries", "params": {
+			"source": {"collections": ["companies"]}, "destination": {"collection": "companies"}, "limit": 100}}]}`)
+	})
+	return client
+}
+
+func TestDumpRestore(t *testing.T) {
+	ctx := context.Background()
+
+	var archive bytes.Buffer
+	require.NoError(t, Dump(ctx, source(t), &archive))
+
+	target, mux := setup(t)
+	var calls []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, bytes.TrimSpace(body)))
+		if r.URL.Path == "/collections/companies/documents/import" {
+			fmt.Fprintln(w, `{"success": true}`)
+			fmt.Fprintln(w, `{"success": true}`)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}
+	mux.HandleFunc("/", record)
+
+	opts := &RestoreOptions{Exclude: []string{"log*"}}
+	require.NoError(t, Restore(ctx, target, &archive, opts))
+
+	want := []string{
+		`POST /collections {"fields":[{"name":"company_name","type":"string"}],"name":"companies"}`,
+		"POST /collections/companies/documents/import {\"id\":\"1\",\"company_name\":\"Stark Industries\"}\n{\"id\":\"2\",\"company_name\":\"Orbit Inc.\"}",
+		`PUT /collections/companies/synonyms/inc {"synonyms":["inc","incorporated"]}`,
+		`PUT /collections/companies/overrides/pin-stark {"includes":[{"id":"1","position":1}],"rule":{"match":"exact","query":"stark"}}`,
+		`PUT /aliases/firms {"collection_name":"companies"}`,
+		`PUT /presets/listing_view {"value":{"q":"*","sort_by":"popularity"}}`,
+		`POST /analytics/rules {"name":"company_queries","type":"popular_queries","params":{"source":{"collections":["companies"]},"destination":{"collection":"companies"},"limit":100}}`,
+	}
+	assert.Equal(t, want, calls)
+}
+
+func TestDump_Index(t *testing.T) {
+	ctx := context.Background()
+
+	var archive bytes.Buffer
+	require.NoError(t, Dump(ctx, source(t), &archive))
+
+	files, index := readArchive(t, &archive)
+	assert.Equal(t, []string{"companies", "logs"}, index.Collections)
+	assert.Equal(t, formatVersion, index.Version)
+
+	sort.Strings(files)
+	assert.Equal(t, []string{
+		"aliases.json",
+		"analytics_rules.json",
+		"backup.json",
+		"collections/companies/documents.jsonl",
+		"collections/companies/overrides.json",
+		"collections/companies/schema.json",
+		"collections/companies/synonyms.json",
+		"collections/logs/documents.jsonl",
+		"collections/logs/overrides.json",
+		"collections/logs/schema.json",
+		"collections/logs/synonyms.json",
+		"presets.json",
+	}, files)
+}
+
+func TestRestore_NotABackup(t *testing.T) {
+	client, _ := setup(t)
+	err := Restore(context.Background(), client, bytes.NewReader([]byte("garbage")), nil)
+	assert.Error(t, err)
+}
+
+func TestRestoreOptions_Includes(t *testing.T) {
+	var opts *RestoreOptions
+	assert.True(t, opts.includes("companies"))
+
+	opts = &RestoreOptions{Include: []string{"products_*", "companies"}, Exclude: []string{"products_tmp"}}
+	assert.True(t, opts.includes("companies"))
+	assert.True(t, opts.includes("products_v2"))
+	assert.False(t, opts.includes("products_tmp"))
+	assert.False(t, opts.includes("logs"))
+}
+
+func readArchive(t *testing.T, r io.Reader) ([]string, *Index) {
+	dec, err := zstd.NewReader(r)
+	require.NoError(t, err)
+	defer dec.Close()
+	zr := tar.NewReader(dec)
+
+	var files []string
+	index := &Index{}
+	for {
+		hdr, err := zr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		files = append(files, hdr.Name)
+		if hdr.Name == indexFile {
+			require.NoError(t, json.NewDecoder(zr).Decode(index))
+		}
+	}
+	return files, index
+}
+
+func TestSortByReferences(t *testing.T) {
+	ref := func(name, reference string) *typesense.Field {
+		return &typesense.Field{Name: name, Type: "string", Reference: typesense.String(reference)}
+	}
+	collections := []*typesense.CollectionSchema{
+		{Name: "books", Fields: []*typesense.Field{ref("author_id", "authors.id")}},
+		{Name: "reviews", Fields: []*typesense.Field{ref("book_id", "books.id"), ref("reply_to", "reviews.id")}},
+		{Name: "authors"},
+		{Name: "orders", Fields: []*typesense.Field{ref("customer_id", "customers.id")}},
+		{Name: "customers", Fields: []*typesense.Field{ref("last_order_id", "orders.id")}},
+		{Name: "logs", Fields: []*typesense.Field{ref("user_id", "users.id")}},
+	}
+
+	var got []string
+	for _, c := range sortByReferences(collections) {
+		got = append(got, c.Name)
+	}
+	assert.Equal(t, []string{"authors", "books", "reviews", "customers", "orders", "logs"}, got)
+}
@@ -0,0 +1,270 @@
+package backup
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/klauspost/compress/zstd"
+)
+
+// RestoreOptions selects what is restored from an archive.
+type RestoreOptions struct {
+	// Include restricts the restore to collections whose name matches any of
+	// these path.Match patterns. All collections are restored when empty.
+	Include []string
+
+	// Exclude skips collections whose name matches any of these patterns.
+	Exclude []string
+
+	// ImportOptions is passed to every document import.
+	ImportOptions *typesense.ImportDocumentsParams
+}
+
+func (o *RestoreOptions) includes(name string) bool {
+	if o == nil {
+		return true
+	}
+	for _, pattern := range o.Exclude {
+		if ok, _ := path.Match(pattern, name); ok {
+			return false
+		}
+	}
+	if len(o.Include) == 0 {
+		return true
+	}
+	for _, pattern := range o.Include {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ImportError is returned by Restore when documents fail to import.
+type ImportError struct {
+	Collection string
+	Failed     []*typesense.ImportDocumentResponse
+}
+
+func (e *ImportError) Error() string {
+	return fmt.Sprintf("collection %s: %d documents failed to import", e.Collection, len(e.Failed))
+}
+
+// Restore recreates the collections, documents, synonyms, overrides, aliases,
+// presets and analytics rules of an archive written by Dump. Aliases and
+// analytics rules are skipped when they refer to a collection that was not
+// restored. Collections must not exist in the target cluster; they are
+// created in archive order, in which referenced collections come first.
+func Restore(ctx context.Context, client *typesense.Client, r io.Reader, opts *RestoreOptions) error {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return err
+	}
+	if hdr.Name != indexFile {
+		return errors.New("not a typesense backup: missing " + indexFile)
+	}
+	index := &Index{}
+	if err := json.NewDecoder(tr).Decode(index); err != nil {
+		return err
+	}
+	if index.Version != formatVersion {
+		return fmt.Errorf("unsupported backup version %d", index.Version)
+	}
+
+	restored := make(map[string]bool)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if collection, file, ok := collectionEntry(hdr.Name); ok {
+			if !opts.includes(collection) {
+				continue
+			}
+			if file != schemaFile && !restored[collection] {
+				return fmt.Errorf("collection %s: %s precedes %s", collection, file, schemaFile)
+			}
+			if err := restoreCollectionEntry(ctx, client, collection, file, tr, opts); err != nil {
+				return fmt.Errorf("collection %s: %w", collection, err)
+			}
+			restored[collection] = true
+			continue
+		}
+
+		switch hdr.Name {
+		case aliasesFile:
+			err = restoreAliases(ctx, client, tr, restored)
+		case presetsFile:
+			err = restorePresets(ctx, client, tr)
+		case analyticsRulesFile:
+			err = restoreAnalyticsRules(ctx, client, tr, restored)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// collectionEntry splits "collections/<name>/<file>".
+func collectionEntry(name string) (collection, file string, ok bool) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 || parts[0] != "collections" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+func restoreCollectionEntry(ctx context.Context, client *typesense.Client, collection, file string, r io.Reader, opts *RestoreOptions) error {
+	switch file {
+	case schemaFile:
+		schema := &typesense.CollectionSchema{}
+		if err := json.NewDecoder(r).Decode(schema); err != nil {
+			return err
+		}
+		schema.Name = collection
+		_, err := client.Collections.Create(ctx, schema)
+		return err
+
+	case documentsFile:
+		var importOpts *typesense.ImportDocumentsParams
+		if opts != nil {
+			importOpts = opts.ImportOptions
+		}
+		res, err := client.Documents.ImportJSONL(ctx, collection, r, importOpts)
+		if err != nil {
+			return err
+		}
+		var failed []*typesense.ImportDocumentResponse
+		for _, line := range res {
+			if !line.Success {
+				failed = append(failed, line)
+			}
+		}
+		if len(failed) > 0 {
+			return &ImportError{Collection: collection, Failed: failed}
+		}
+		return nil
+
+	case synonymsFile:
+		var synonyms []*typesense.SearchSynonym
+		if err := json.NewDecoder(r).Decode(&synonyms); err != nil {
+			return err
+		}
+		for _, s := range synonyms {
+			if s.Id == nil {
+				continue
+			}
+			body := &typesense.SearchSynonymSchema{Root: s.Root, Synonyms: s.Synonyms}
+			if _, err := client.Synonyms.Upsert(ctx, collection, *s.Id, body); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case overridesFile:
+		var overrides []*typesense.SearchOverride
+		if err := json.NewDecoder(r).Decode(&overrides); err != nil {
+			return err
+		}
+		for _, o := range overrides {
+			if o.Id == nil {
+				continue
+			}
+			body := &typesense.SearchOverrideSchema{}
+			if err := convert(o, body); err != nil {
+				return err
+			}
+			if _, err := client.Overrides.Upsert(ctx, collection, *o.Id, body); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func restoreAliases(ctx context.Context, client *typesense.Client, r io.Reader, restored map[string]bool) error {
+	var aliases []*typesense.CollectionAlias
+	if err := json.NewDecoder(r).Decode(&aliases); err != nil {
+		return err
+	}
+	for _, alias := range aliases {
+		if !restored[alias.CollectionName] {
+			continue
+		}
+		body := &typesense.CollectionAliasSchema{CollectionName: alias.CollectionName}
+		if _, err := client.Aliases.Upsert(ctx, alias.Name, body); err != nil {
+			return fmt.Errorf("alias %s: %w", alias.Name, err)
+		}
+	}
+	return nil
+}
+
+func restorePresets(ctx context.Context, client *typesense.Client, r io.Reader) error {
+	var presets []*typesense.Preset
+	if err := json.NewDecoder(r).Decode(&presets); err != nil {
+		return err
+	}
+	for _, p := range presets {
+		value, ok := p.Value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("preset %s: invalid value", p.Name)
+		}
+		if _, err := client.Presets.Upsert(ctx, p.Name, &typesense.PresetUpsertSchema{Value: value}); err != nil {
+			return fmt.Errorf("preset %s: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+func restoreAnalyticsRules(ctx context.Context, client *typesense.Client, r io.Reader, restored map[string]bool) error {
+	var rules []*typesense.AnalyticsRule
+	if err := json.NewDecoder(r).Decode(&rules); err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if !restored[rule.Params.Destination.Collection] {
+			continue
+		}
+		skip := false
+		for _, c := range rule.Params.Source.Collections {
+			if !restored[c] {
+				skip = true
+			}
+		}
+		if skip {
+			continue
+		}
+		if _, err := client.AnalyticsRules.Create(ctx, rule); err != nil {
+			return fmt.Errorf("analytics rule %s: %w", rule.Name, err)
+		}
+	}
+	return nil
+}
+
+// convert copies src into dst through their JSON representation.
+func convert(src, dst interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
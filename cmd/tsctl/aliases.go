@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func aliasCommands() []*command {
+	return []*command{
+		{
+			path:    "aliases list",
+			summary: "List aliases",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Aliases.List(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, aliasTable(res...))
+			}),
+		},
+		{
+			path:    "aliases get",
+			args:    "<alias>",
+			summary: "Show an alias",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Aliases.Get(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, aliasTable(res))
+			}),
+		},
+		{
+			path:    "aliases upsert",
+			args:    "<alias> <collection>",
+			summary: "Point an alias to a collection",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.CollectionAliasSchema{CollectionName: args[1]}
+				res, err := a.client.Aliases.Upsert(ctx, args[0], body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, aliasTable(res))
+			}),
+		},
+		{
+			path:    "aliases delete",
+			args:    "<alias>",
+			summary: "Delete an alias",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Aliases.Delete(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, aliasTable(res))
+			}),
+		},
+	}
+}
+
+func aliasTable(aliases ...*typesense.CollectionAlias) *table {
+	t := &table{header: []string{"NAME", "COLLECTION"}}
+	for _, alias := range aliases {
+		t.add(alias.Name, alias.CollectionName)
+	}
+	return t
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func analyticsCommands() []*command {
+	return []*command{
+		{
+			path:    "analytics rules list",
+			summary: "List analytics rules",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.AnalyticsRules.List(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, ruleTable(res.Rules...))
+			}),
+		},
+		{
+			path:    "analytics rules get",
+			args:    "<name>",
+			summary: "Show an analytics rule",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.AnalyticsRules.Get(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, ruleTable(res))
+			}),
+		},
+		{
+			path:    "analytics rules create",
+			args:    "<rule.json>",
+			summary: "Create an analytics rule",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.AnalyticsRule{}
+				if err := a.readJSON(args[0], body); err != nil {
+					return err
+				}
+				res, err := a.client.AnalyticsRules.Create(ctx, body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, ruleTable(res))
+			}),
+		},
+		{
+			path:    "analytics rules upsert",
+			args:    "<name> <rule.json>",
+			summary: "Create or update an analytics rule",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.AnalyticsRuleUpsertSchema{}
+				if err := a.readJSON(args[1], body); err != nil {
+					return err
+				}
+				res, err := a.client.AnalyticsRules.Upsert(ctx, args[0], body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, ruleTable(res))
+			}),
+		},
+		{
+			path:    "analytics rules delete",
+			args:    "<name>",
+			summary: "Delete an analytics rule",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.AnalyticsRules.Delete(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+		{
+			path:    "analytics events create",
+			args:    "<event.json>",
+			summary: "Send an analytics event",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.AnalyticsEvent{}
+				if err := a.readJSON(args[0], body); err != nil {
+					return err
+				}
+				res, err := a.client.AnalyticsEvents.Create(ctx, body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+	}
+}
+
+func ruleTable(rules ...*typesense.AnalyticsRule) *table {
+	t := &table{header: []string{"NAME", "TYPE", "SOURCE", "DESTINATION", "LIMIT"}}
+	for _, r := range rules {
+		destination := r.Params.Destination.Collection
+		if r.Params.Destination.CounterField != nil {
+			destination += "." + *r.Params.Destination.CounterField
+		}
+		t.add(r.Name, string(r.Type), strings.Join(r.Params.Source.Collections, ","),
+			destination, fmt.Sprint(r.Params.Limit))
+	}
+	return t
+}
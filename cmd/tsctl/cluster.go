@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func clusterCommands() []*command {
+	return []*command{
+		{
+			path:    "health",
+			summary: "Show the health of the node",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Meta.Health(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+		{
+			path:    "status",
+			summary: "Show the raft status of the node",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Meta.Status(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+		{
+			path:    "debug",
+			summary: "Show the version and raft state of the node",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Meta.Debug(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+		{
+			path:    "metrics",
+			summary: "Show system and memory metrics of the node",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Meta.Metrics(ctx)
+				if err != nil {
+					return err
+				}
+				m := *res
+				m.SystemCPUIndividualPercentage = nil
+				t := kvTable(&m)
+				for _, c := range res.SystemCPUIndividualPercentage {
+					t.add(fmt.Sprintf("system_cpu%d_active_percentage", c.CPU), fmt.Sprint(c.Percentage))
+				}
+				t.add("memory_utilization", fmt.Sprintf("%.4f", res.MemoryUtilization()))
+				t.add("disk_utilization", fmt.Sprintf("%.4f", res.DiskUtilization()))
+				t.add("fragmentation", fmt.Sprintf("%.4f", res.Fragmentation()))
+				return a.print(res, t)
+			}),
+		},
+		{
+			path:    "stats",
+			summary: "Show request rates and latencies of the node",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Meta.Stats(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+		{
+			path:    "operations snapshot",
+			flags:   "-path dir",
+			summary: "Create a snapshot on the node's file system",
+			run: func(ctx context.Context, a *app, args []string) error {
+				fs := flag.NewFlagSet("operations snapshot", flag.ContinueOnError)
+				path := fs.String("path", "", "directory the snapshot is written to on the server")
+				if _, err := parseArgs(fs, args, 0); err != nil || *path == "" {
+					return errUsage
+				}
+				res, err := a.client.Operations.Snapshot(ctx, &typesense.TakeSnapshotParams{SnapshotPath: *path})
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			},
+		},
+		{
+			path:    "operations vote",
+			summary: "Make the node step down and trigger a leader election",
+			run:     operation((*typesense.OperationsService).Vote),
+		},
+		{
+			path:    "operations compact",
+			summary: "Compact the on-disk database",
+			run:     operation((*typesense.OperationsService).CompactDB),
+		},
+		{
+			path:    "operations cache-clear",
+			summary: "Clear the search cache",
+			run:     operation((*typesense.OperationsService).ClearCache),
+		},
+		{
+			path:    "operations reset-peers",
+			summary: "Reset the raft peers of the node",
+			run:     operation((*typesense.OperationsService).ResetPeers),
+		},
+	}
+}
+
+func operation(fn func(*typesense.OperationsService, context.Context) (*typesense.SuccessStatus, error)) func(ctx context.Context, a *app, args []string) error {
+	return exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+		res, err := fn(a.client.Operations, ctx)
+		if err != nil {
+			return err
+		}
+		return a.print(res, kvTable(res))
+	})
+}
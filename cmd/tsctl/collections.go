@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func collectionCommands() []*command {
+	return []*command{
+		{
+			path:    "collections list",
+			summary: "List collections",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Collections.List(ctx)
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"NAME", "FIELDS", "DEFAULT SORTING FIELD"}}
+				for _, c := range res {
+					t.add(c.Name, fmt.Sprint(len(c.Fields)), str(c.DefaultSortingField))
+				}
+				return a.print(res, t)
+			}),
+		},
+		{
+			path:    "collections get",
+			args:    "<name>",
+			summary: "Show a collection",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Collections.Get(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, collectionTable(res))
+			}),
+		},
+		{
+			path:    "collections create",
+			args:    "<schema.json>",
+			summary: "Create a collection from a schema file",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				schema := &typesense.CollectionSchema{}
+				if err := a.readJSON(args[0], schema); err != nil {
+					return err
+				}
+				res, err := a.client.Collections.Create(ctx, schema)
+				if err != nil {
+					return err
+				}
+				return a.print(res, collectionTable(res))
+			}),
+		},
+		{
+			path:    "collections update",
+			args:    "<name> <fields.json>",
+			summary: "Add or drop fields of a collection",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				schema := &typesense.CollectionUpdateSchema{}
+				if err := a.readJSON(args[1], schema); err != nil {
+					return err
+				}
+				res, err := a.client.Collections.Update(ctx, args[0], schema)
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+		{
+			path:    "collections delete",
+			args:    "<name>",
+			summary: "Delete a collection",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Collections.Delete(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, collectionTable(res))
+			}),
+		},
+	}
+}
+
+func collectionTable(c *typesense.Collection) *table {
+	t := &table{header: []string{"NAME", "DOCUMENTS", "FIELDS", "CREATED"}}
+	created := "-"
+	if c.CreatedAt != nil {
+		created = time.Unix(*c.CreatedAt, 0).UTC().Format(time.RFC3339)
+	}
+	t.add(c.Name, num(c.NumDocuments), fmt.Sprint(len(c.Fields)), created)
+	return t
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func conversationCommands() []*command {
+	return []*command{
+		{
+			path:    "conversations models list",
+			summary: "List conversation models",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Conversations.ListModels(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, conversationModelTable(res...))
+			}),
+		},
+		{
+			path:    "conversations models get",
+			args:    "<id>",
+			summary: "Show a conversation model",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Conversations.GetModel(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+		{
+			path:    "conversations models create",
+			args:    "<model.json>",
+			summary: "Create a conversation model",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.ConversationModelSchema{}
+				if err := a.readJSON(args[0], body); err != nil {
+					return err
+				}
+				res, err := a.client.Conversations.CreateModel(ctx, body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, conversationModelTable(res))
+			}),
+		},
+		{
+			path:    "conversations models update",
+			args:    "<id> <model.json>",
+			summary: "Update a conversation model",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.ConversationModelSchema{}
+				if err := a.readJSON(args[1], body); err != nil {
+					return err
+				}
+				res, err := a.client.Conversations.UpdateModel(ctx, args[0], body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, conversationModelTable(res))
+			}),
+		},
+		{
+			path:    "conversations models delete",
+			args:    "<id>",
+			summary: "Delete a conversation model",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Conversations.DeleteModel(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, conversationModelTable(res))
+			}),
+		},
+		{
+			path:    "conversations list",
+			summary: "List conversation histories",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Conversations.List(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, conversationTable(res.Conversations...))
+			}),
+		},
+		{
+			path:    "conversations get",
+			args:    "<id>",
+			summary: "Show a conversation history",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Conversations.Get(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+		{
+			path:    "conversations delete",
+			args:    "<id>",
+			summary: "Delete a conversation history",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Conversations.Delete(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, conversationTable(res))
+			}),
+		},
+	}
+}
+
+func conversationModelTable(models ...*typesense.ConversationModel) *table {
+	t := &table{header: []string{"ID", "MODEL", "MAX BYTES", "HISTORY COLLECTION"}}
+	for _, m := range models {
+		t.add(m.Id, m.ModelName, strconv.Itoa(m.MaxBytes), str(m.HistoryCollection))
+	}
+	return t
+}
+
+func conversationTable(conversations ...*typesense.Conversation) *table {
+	t := &table{header: []string{"ID", "MESSAGES", "LAST UPDATED", "TTL"}}
+	for _, c := range conversations {
+		t.add(c.Id, strconv.Itoa(len(c.Conversation)),
+			time.Unix(c.LastUpdated, 0).UTC().Format(time.RFC3339), strconv.Itoa(c.Ttl))
+	}
+	return t
+}
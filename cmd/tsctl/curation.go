@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func curationCommands() []*command {
+	return []*command{
+		{
+			path:    "synonyms list",
+			args:    "<collection>",
+			summary: "List synonyms of a collection",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Synonyms.List(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, synonymTable(res.Synonyms...))
+			}),
+		},
+		{
+			path:    "synonyms get",
+			args:    "<collection> <id>",
+			summary: "Show a synonym",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Synonyms.Get(ctx, args[0], args[1])
+				if err != nil {
+					return err
+				}
+				return a.print(res, synonymTable(res))
+			}),
+		},
+		{
+			path:    "synonyms upsert",
+			args:    "<collection> <id> <synonym.json>",
+			summary: "Create or update a synonym",
+			run: exactArgs(3, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.SearchSynonymSchema{}
+				if err := a.readJSON(args[2], body); err != nil {
+					return err
+				}
+				res, err := a.client.Synonyms.Upsert(ctx, args[0], args[1], body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, synonymTable(res))
+			}),
+		},
+		{
+			path:    "synonyms delete",
+			args:    "<collection> <id>",
+			summary: "Delete a synonym",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Synonyms.Delete(ctx, args[0], args[1])
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+		{
+			path:    "overrides list",
+			args:    "<collection>",
+			summary: "List overrides of a collection",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Overrides.List(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, overrideTable(res.Overrides...))
+			}),
+		},
+		{
+			path:    "overrides get",
+			args:    "<collection> <id>",
+			summary: "Show an override",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Overrides.Get(ctx, args[0], args[1])
+				if err != nil {
+					return err
+				}
+				return a.print(res, overrideTable(res))
+			}),
+		},
+		{
+			path:    "overrides upsert",
+			args:    "<collection> <id> <override.json>",
+			summary: "Create or update an override",
+			run: exactArgs(3, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.SearchOverrideSchema{}
+				if err := a.readJSON(args[2], body); err != nil {
+					return err
+				}
+				res, err := a.client.Overrides.Upsert(ctx, args[0], args[1], body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, overrideTable(res))
+			}),
+		},
+		{
+			path:    "overrides delete",
+			args:    "<collection> <id>",
+			summary: "Delete an override",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Overrides.Delete(ctx, args[0], args[1])
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+		{
+			path:    "presets list",
+			summary: "List presets",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Presets.List(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, presetTable(res.Presets...))
+			}),
+		},
+		{
+			path:    "presets get",
+			args:    "<name>",
+			summary: "Show a preset",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Presets.Get(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, presetTable(res))
+			}),
+		},
+		{
+			path:    "presets upsert",
+			args:    "<name> <value.json>",
+			summary: "Create or update a preset from its value",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				var value map[string]interface{}
+				if err := a.readJSON(args[1], &value); err != nil {
+					return err
+				}
+				res, err := a.client.Presets.Upsert(ctx, args[0], &typesense.PresetUpsertSchema{Value: value})
+				if err != nil {
+					return err
+				}
+				return a.print(res, presetTable(res))
+			}),
+		},
+		{
+			path:    "presets delete",
+			args:    "<name>",
+			summary: "Delete a preset",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Presets.Delete(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+		{
+			path:    "stopwords list",
+			summary: "List stopwords sets",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Stopwords.List(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, stopwordsTable(res.Stopwords...))
+			}),
+		},
+		{
+			path:    "stopwords get",
+			args:    "<id>",
+			summary: "Show a stopwords set",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Stopwords.Get(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, stopwordsTable(res))
+			}),
+		},
+		{
+			path:    "stopwords upsert",
+			args:    "<id> <stopwords.json>",
+			summary: "Create or update a stopwords set",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.StopwordsSetSchema{}
+				if err := a.readJSON(args[1], body); err != nil {
+					return err
+				}
+				res, err := a.client.Stopwords.Upsert(ctx, args[0], body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, stopwordsTable(res))
+			}),
+		},
+		{
+			path:    "stopwords delete",
+			args:    "<id>",
+			summary: "Delete a stopwords set",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Stopwords.Delete(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+	}
+}
+
+func synonymTable(synonyms ...*typesense.SearchSynonym) *table {
+	t := &table{header: []string{"ID", "ROOT", "SYNONYMS"}}
+	for _, s := range synonyms {
+		t.add(str(s.Id), str(s.Root), strings.Join(s.Synonyms, ","))
+	}
+	return t
+}
+
+func overrideTable(overrides ...*typesense.SearchOverride) *table {
+	t := &table{header: []string{"ID", "MATCH", "QUERY", "INCLUDES", "EXCLUDES"}}
+	for _, o := range overrides {
+		var includes, excludes []string
+		for _, in := range o.Includes {
+			includes = append(includes, in.Id)
+		}
+		for _, ex := range o.Excludes {
+			excludes = append(excludes, ex.Id)
+		}
+		t.add(str(o.Id), string(o.Rule.Match), o.Rule.Query,
+			strings.Join(includes, ","), strings.Join(excludes, ","))
+	}
+	return t
+}
+
+func presetTable(presets ...*typesense.Preset) *table {
+	t := &table{header: []string{"NAME", "VALUE"}}
+	for _, p := range presets {
+		t.add(p.Name, cell(p.Value))
+	}
+	return t
+}
+
+func stopwordsTable(sets ...*typesense.StopwordsSet) *table {
+	t := &table{header: []string{"ID", "LOCALE", "STOPWORDS"}}
+	for _, s := range sets {
+		t.add(s.Id, str(s.Locale), strings.Join(s.Stopwords, ","))
+	}
+	return t
+}
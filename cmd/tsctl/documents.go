@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func documentCommands() []*command {
+	return []*command{
+		{
+			path:    "documents get",
+			args:    "<collection> <id>",
+			summary: "Show a document",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Documents.Get(ctx, args[0], args[1])
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+		{
+			path:    "documents delete",
+			args:    "<collection> <id>",
+			summary: "Delete a document",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Documents.Delete(ctx, args[0], args[1])
+				if err != nil {
+					return err
+				}
+				return a.print(res, kvTable(res))
+			}),
+		},
+		{
+			path:    "documents import",
+			args:    "<collection> <file.jsonl>",
+			flags:   "[-action create|upsert|update|emplace] [-batch-size n] [-dirty-values mode]",
+			summary: "Import documents from a JSONL file",
+			run:     importDocuments,
+		},
+		{
+			path:    "documents export",
+			args:    "<collection>",
+			flags:   "[-f file.jsonl] [-filter-by expr] [-include-fields fields] [-exclude-fields fields]",
+			summary: "Export documents as JSONL",
+			run:     exportDocuments,
+		},
+		{
+			path:    "documents search",
+			args:    "<collection>",
+			flags:   "-q query -query-by fields [-filter-by expr] [-sort-by expr] [-facet-by fields] [-page n] [-per-page n]",
+			summary: "Search a collection",
+			run:     searchDocuments,
+		},
+	}
+}
+
+func importDocuments(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("documents import", flag.ContinueOnError)
+	action := fs.String("action", "", "import action")
+	batchSize := fs.Int("batch-size", 0, "number of documents indexed per batch on the server")
+	dirtyValues := fs.String("dirty-values", "", "how to handle values that do not match the schema")
+	args, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return errUsage
+	}
+
+	opts := &typesense.ImportDocumentsParams{}
+	if *action != "" {
+		opts.Action = action
+	}
+	if *batchSize > 0 {
+		opts.BatchSize = batchSize
+	}
+	if *dirtyValues != "" {
+		opts.DirtyValues = dirtyValues
+	}
+
+	r, err := a.open(args[1])
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	res, err := a.client.Documents.ImportJSONL(ctx, args[0], r, opts)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	t := &table{header: []string{"LINE", "CODE", "ERROR"}}
+	for i, line := range res {
+		if line.Success {
+			continue
+		}
+		failed++
+		t.add(fmt.Sprint(i+1), num(line.Code), str(line.Error))
+	}
+	if a.format == "json" {
+		if err := a.print(res, nil); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(a.out, "imported %d of %d documents\n", len(res)-failed, len(res))
+		if failed > 0 {
+			if err := a.print(res, t); err != nil {
+				return err
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d documents failed to import", failed)
+	}
+	return nil
+}
+
+func exportDocuments(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("documents export", flag.ContinueOnError)
+	file := fs.String("f", "", "write to this file instead of standard output")
+	opts := &typesense.ExportDocumentsParams{}
+	fs.StringVar(&opts.FilterBy, "filter-by", "", "filter conditions")
+	fs.StringVar(&opts.IncludeFields, "include-fields", "", "fields to include")
+	fs.StringVar(&opts.ExcludeFields, "exclude-fields", "", "fields to exclude")
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return errUsage
+	}
+
+	if *file == "" {
+		return a.client.Documents.ExportJSONL(ctx, args[0], a.out, opts)
+	}
+	f, err := os.Create(*file)
+	if err != nil {
+		return err
+	}
+	if err := a.client.Documents.ExportJSONL(ctx, args[0], f, opts); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func searchDocuments(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("documents search", flag.ContinueOnError)
+	params := &typesense.SearchParameters{}
+	fs.StringVar(&params.Q, "q", "*", "query text")
+	fs.StringVar(&params.QueryBy, "query-by", "", "fields to query")
+	filterBy := fs.String("filter-by", "", "filter conditions")
+	sortBy := fs.String("sort-by", "", "sort order")
+	facetBy := fs.String("facet-by", "", "fields to facet on")
+	page := fs.Int("page", 0, "page number")
+	perPage := fs.Int("per-page", 0, "results per page")
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return errUsage
+	}
+
+	if *filterBy != "" {
+		params.FilterBy = filterBy
+	}
+	if *sortBy != "" {
+		params.SortBy = sortBy
+	}
+	if *facetBy != "" {
+		params.FacetBy = facetBy
+	}
+	if *page > 0 {
+		params.Page = page
+	}
+	if *perPage > 0 {
+		params.PerPage = perPage
+	}
+
+	res, err := a.client.Documents.Search(ctx, args[0], params)
+	if err != nil {
+		return err
+	}
+
+	t := &table{header: []string{"ID", "TEXT MATCH", "DOCUMENT"}}
+	for _, hit := range res.Hits {
+		doc, _ := json.Marshal(hit.Document)
+		t.add(cell(hit.Document["id"]), num(hit.TextMatch), string(doc))
+	}
+	if a.format == "table" {
+		fmt.Fprintf(a.out, "found %s of %s documents\n", num(res.Found), num(res.OutOf))
+	}
+	return a.print(res, t)
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func keyCommands() []*command {
+	return []*command{
+		{
+			path:    "keys list",
+			summary: "List API keys",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Keys.List(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, keyTable(res.Keys...))
+			}),
+		},
+		{
+			path:    "keys get",
+			args:    "<id>",
+			summary: "Show an API key",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				id, err := strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+				res, err := a.client.Keys.Get(ctx, id)
+				if err != nil {
+					return err
+				}
+				return a.print(res, keyTable(res))
+			}),
+		},
+		{
+			path:    "keys create",
+			flags:   "-actions a,b -collections c,d [-description text] [-expires-in duration] [-value key] | -f schema.json",
+			summary: "Create an API key",
+			run:     createKey,
+		},
+		{
+			path:    "keys lint",
+			summary: "List risky API keys",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.Keys.List(ctx)
+				if err != nil {
+					return err
+				}
+				issues := typesense.LintKeys(res.Keys, time.Now())
+				t := &table{header: []string{"RISK", "ID", "DESCRIPTION", "ISSUE"}}
+				for _, i := range issues {
+					t.add(i.Risk.String(), num(i.Key.Id), i.Key.Description, i.Message)
+				}
+				return a.print(issues, t)
+			}),
+		},
+		{
+			path:    "keys delete",
+			args:    "<id>",
+			summary: "Delete an API key",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				id, err := strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+				res, err := a.client.Keys.Delete(ctx, id)
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+	}
+}
+
+func createKey(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("keys create", flag.ContinueOnError)
+	file := fs.String("f", "", "read the key schema from this file")
+	actions := fs.String("actions", "", "comma separated list of actions")
+	collections := fs.String("collections", "", "comma separated list of collections")
+	description := fs.String("description", "", "key description")
+	expiresIn := fs.Duration("expires-in", 0, "key lifetime")
+	value := fs.String("value", "", "key value, generated by the server if empty")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return errUsage
+	}
+
+	schema := &typesense.ApiKeySchema{}
+	if *file != "" {
+		if err := a.readJSON(*file, schema); err != nil {
+			return err
+		}
+	} else {
+		if *actions == "" || *collections == "" {
+			return errUsage
+		}
+		for _, action := range strings.Split(*actions, ",") {
+			schema.Actions = append(schema.Actions, typesense.KeyAction(action))
+		}
+		schema.Collections = strings.Split(*collections, ",")
+		schema.Description = description
+		if *expiresIn > 0 {
+			expiresAt := time.Now().Add(*expiresIn).Unix()
+			schema.ExpiresAt = &expiresAt
+		}
+		if *value != "" {
+			schema.Value = value
+		}
+	}
+
+	res, err := a.client.Keys.Create(ctx, schema)
+	if err != nil {
+		return err
+	}
+	return a.print(res, kvTable(res))
+}
+
+func keyTable(keys ...*typesense.ApiKey) *table {
+	t := &table{header: []string{"ID", "PREFIX", "DESCRIPTION", "ACTIONS", "COLLECTIONS", "EXPIRES"}}
+	for _, k := range keys {
+		expires := "-"
+		if k.ExpiresAt != nil {
+			expires = time.Unix(*k.ExpiresAt, 0).UTC().Format(time.RFC3339)
+		}
+		actions := make([]string, len(k.Actions))
+		for i, a := range k.Actions {
+			actions[i] = string(a)
+		}
+		t.add(num(k.Id), str(k.ValuePrefix), k.Description,
+			strings.Join(actions, ","), strings.Join(k.Collections, ","), expires)
+	}
+	return t
+}
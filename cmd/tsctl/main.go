@@ -0,0 +1,179 @@
+// Command tsctl manages a Typesense cluster from the command line.
+//
+// Usage:
+//
+//	tsctl [global flags] <command> [arguments] [flags]
+//
+// The server URL and API key are resolved from the --server and --api-key
+// flags, then the TYPESENSE_URL and TYPESENSE_API_KEY environment variables,
+// then the selected profile of the config file (see profile.go). Run tsctl
+// without arguments to list every command.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+type app struct {
+	client *typesense.Client
+	in     io.Reader
+	out    io.Writer
+	format string
+}
+
+type command struct {
+	path    string
+	args    string
+	flags   string
+	summary string
+	run     func(ctx context.Context, a *app, args []string) error
+}
+
+var errUsage = errors.New("usage")
+
+func commands() []*command {
+	var cmds []*command
+	cmds = append(cmds, collectionCommands()...)
+	cmds = append(cmds, documentCommands()...)
+	cmds = append(cmds, aliasCommands()...)
+	cmds = append(cmds, keyCommands()...)
+	cmds = append(cmds, curationCommands()...)
+	cmds = append(cmds, conversationCommands()...)
+	cmds = append(cmds, analyticsCommands()...)
+	cmds = append(cmds, rateLimitCommands()...)
+	cmds = append(cmds, clusterCommands()...)
+	return cmds
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintf(os.Stderr, "tsctl: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, in io.Reader, out, errOut io.Writer) error {
+	fs := flag.NewFlagSet("tsctl", flag.ContinueOnError)
+	fs.SetOutput(errOut)
+	configPath := fs.String("config", "", "path of the config file (env TSCTL_CONFIG)")
+	profileName := fs.String("profile", "", "profile of the config file to use (env TSCTL_PROFILE)")
+	server := fs.String("server", "", "Typesense server URL (env TYPESENSE_URL)")
+	apiKey := fs.String("api-key", "", "Typesense API key (env TYPESENSE_API_KEY)")
+	format := fs.String("o", "table", "output format: table or json")
+	fs.Usage = func() { usage(errOut, fs) }
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if *format != "table" && *format != "json" {
+		return fmt.Errorf("unknown output format %q", *format)
+	}
+
+	cmd, rest := lookup(fs.Args())
+	if cmd == nil {
+		usage(errOut, fs)
+		return errUsage
+	}
+
+	p, err := resolveProfile(*configPath, *profileName, *server, *apiKey)
+	if err != nil {
+		return err
+	}
+	client, err := typesense.NewClient(nil, p.Server, p.APIKey)
+	if err != nil {
+		return err
+	}
+
+	a := &app{client: client, in: in, out: out, format: *format}
+	err = cmd.run(ctx, a, rest)
+	if errors.Is(err, errUsage) {
+		fmt.Fprintf(errOut, "usage: tsctl %s\n", strings.Join(strings.Fields(cmd.path+" "+cmd.args+" "+cmd.flags), " "))
+	}
+	return err
+}
+
+// lookup returns the command whose path is the longest prefix of args.
+func lookup(args []string) (*command, []string) {
+	var found *command
+	n := 0
+	for _, cmd := range commands() {
+		path := strings.Fields(cmd.path)
+		if len(path) <= n || len(path) > len(args) {
+			continue
+		}
+		match := true
+		for i := range path {
+			if path[i] != args[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			found, n = cmd, len(path)
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	return found, args[n:]
+}
+
+func usage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintln(w, "usage: tsctl [global flags] <command> [arguments] [flags]")
+	fmt.Fprintln(w, "\nglobal flags:")
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+	fmt.Fprintln(w, "\ncommands:")
+
+	cmds := commands()
+	sort.SliceStable(cmds, func(i, j int) bool { return cmds[i].path < cmds[j].path })
+	for _, cmd := range cmds {
+		fmt.Fprintf(w, "  %-45s %s\n", strings.TrimSpace(cmd.path+" "+cmd.args), cmd.summary)
+	}
+}
+
+// parseArgs parses flags that may be interspersed with positional arguments
+// and checks that exactly n positional arguments are given.
+func parseArgs(fs *flag.FlagSet, args []string, n int) ([]string, error) {
+	fs.SetOutput(io.Discard)
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	if len(positional) != n {
+		return nil, errUsage
+	}
+	return positional, nil
+}
+
+// exactArgs wraps a command without flags that takes n positional arguments.
+func exactArgs(n int, fn func(ctx context.Context, a *app, args []string) error) func(ctx context.Context, a *app, args []string) error {
+	return func(ctx context.Context, a *app, args []string) error {
+		if len(args) != n {
+			return errUsage
+		}
+		return fn(ctx, a, args)
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) (*http.ServeMux, string) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return mux, server.URL
+}
+
+func TestRun_CollectionsList(t *testing.T) {
+	mux, serverURL := setup(t)
+	mux.HandleFunc("/collections", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "xyz", r.Header.Get("X-TYPESENSE-API-KEY"))
+		fmt.Fprint(w, `[{"name": "companies", "fields": [{"name": "company_name", "type": "string"}], "default_sorting_field": "num_employees"}]`)
+	})
+
+	var out, errOut bytes.Buffer
+	args := []string{"-server", serverURL, "-api-key", "xyz", "collections", "list"}
+	err := run(context.Background(), args, nil, &out, &errOut)
+	require.NoError(t, err)
+
+	want := "NAME       FIELDS  DEFAULT SORTING FIELD\n" +
+		"companies  1       num_employees\n"
+	assert.Equal(t, want, out.String())
+}
+
+func TestRun_DocumentsImport(t *testing.T) {
+	mux, serverURL := setup(t)
+	mux.HandleFunc("/collections/companies/documents/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "upsert", r.URL.Query().Get("action"))
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "{\"id\": \"1\"}\n{\"id\": \"2\"}\n", string(body))
+		fmt.Fprintln(w, `{"success": true}`)
+		fmt.Fprintln(w, `{"success": false, "code": 400, "error": "Bad JSON."}`)
+	})
+
+	var out, errOut bytes.Buffer
+	in := strings.NewReader("{\"id\": \"1\"}\n{\"id\": \"2\"}\n")
+	args := []string{"-server", serverURL, "-api-key", "xyz", "documents", "import", "companies", "-", "-action", "upsert"}
+	err := run(context.Background(), args, in, &out, &errOut)
+	assert.EqualError(t, err, "1 documents failed to import")
+
+	want := "imported 1 of 2 documents\n" +
+		"LINE  CODE  ERROR\n" +
+		"2     400   Bad JSON.\n"
+	assert.Equal(t, want, out.String())
+}
+
+func TestRun_Usage(t *testing.T) {
+	var out, errOut bytes.Buffer
+	args := []string{"-api-key", "xyz", "synonyms", "get", "companies"}
+	err := run(context.Background(), args, nil, &out, &errOut)
+	assert.ErrorIs(t, err, errUsage)
+	assert.Equal(t, "usage: tsctl synonyms get <collection> <id>\n", errOut.String())
+}
+
+func TestResolveProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte(`
+current: local
+profiles:
+  local:
+    server: http://localhost:8108
+    api_key: xyz
+  production:
+    server: https://search.example.com
+    api_key: abc
+  empty:
+`), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("TYPESENSE_URL", "")
+	t.Setenv("TYPESENSE_API_KEY", "")
+
+	p, err := resolveProfile(path, "", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, &profile{Server: "http://localhost:8108", APIKey: "xyz"}, p)
+
+	t.Setenv("TSCTL_PROFILE", "production")
+	p, err = resolveProfile(path, "", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, &profile{Server: "https://search.example.com", APIKey: "abc"}, p)
+
+	t.Setenv("TYPESENSE_API_KEY", "from-env")
+	p, err = resolveProfile(path, "", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", p.APIKey)
+
+	p, err = resolveProfile(path, "", "http://flag:8108", "from-flag")
+	require.NoError(t, err)
+	assert.Equal(t, &profile{Server: "http://flag:8108", APIKey: "from-flag"}, p)
+
+	_, err = resolveProfile(path, "staging", "", "")
+	assert.Error(t, err)
+
+	_, err = resolveProfile(path, "empty", "", "")
+	assert.ErrorContains(t, err, `profile "empty" is empty`)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The config file holds named profiles, e.g.
+//
+//	current: local
+//	profiles:
+//	  local:
+//	    server: http://localhost:8108
+//	    api_key: xyz
+//	  production:
+//	    server: https://search.example.com
+//	    api_key: 2bRpK9Mx7YsW
+//
+// Its default location is $XDG_CONFIG_HOME/tsctl/config.yaml.
+type configFile struct {
+	Current  string              `yaml:"current"`
+	Profiles map[string]*profile `yaml:"profiles"`
+}
+
+type profile struct {
+	Server string `yaml:"server"`
+	APIKey string `yaml:"api_key"`
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "tsctl", "config.yaml")
+}
+
+// resolveProfile merges the flags, the environment and the config file, in
+// that order of precedence.
+func resolveProfile(configPath, profileName, server, apiKey string) (*profile, error) {
+	if configPath == "" {
+		configPath = os.Getenv("TSCTL_CONFIG")
+	}
+	explicit := configPath != ""
+	if !explicit {
+		configPath = defaultConfigPath()
+	}
+	if profileName == "" {
+		profileName = os.Getenv("TSCTL_PROFILE")
+	}
+
+	p := &profile{}
+	if configPath != "" {
+		cfg, err := readConfigFile(configPath)
+		if err != nil && (explicit || !errors.Is(err, os.ErrNotExist)) {
+			return nil, err
+		}
+		if cfg != nil {
+			if profileName == "" {
+				profileName = cfg.Current
+			}
+			if profileName != "" {
+				selected, ok := cfg.Profiles[profileName]
+				if !ok {
+					return nil, fmt.Errorf("profile %q not found in %s", profileName, configPath)
+				}
+				if selected == nil {
+					return nil, fmt.Errorf("profile %q is empty in %s", profileName, configPath)
+				}
+				*p = *selected
+			}
+		}
+	}
+	if v := os.Getenv("TYPESENSE_URL"); v != "" {
+		p.Server = v
+	}
+	if v := os.Getenv("TYPESENSE_API_KEY"); v != "" {
+		p.APIKey = v
+	}
+	if server != "" {
+		p.Server = server
+	}
+	if apiKey != "" {
+		p.APIKey = apiKey
+	}
+	return p, nil
+}
+
+func readConfigFile(path string) (*configFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &configFile{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func rateLimitCommands() []*command {
+	return []*command{
+		{
+			path:    "ratelimits list",
+			summary: "List rate limit rules",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.RateLimits.List(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, rateLimitTable(res...))
+			}),
+		},
+		{
+			path:    "ratelimits active",
+			summary: "List active throttles and bans",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.RateLimits.ListActive(ctx)
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+		{
+			path:    "ratelimits exceeds",
+			summary: "List entities that exceeded a rate limit",
+			run: exactArgs(0, func(ctx context.Context, a *app, args []string) error {
+				res, err := a.client.RateLimits.ListExceeds(ctx)
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"RULE", "IP", "API KEY", "REQUESTS"}}
+				for _, e := range res {
+					t.add(fmt.Sprint(e.Id), e.Ip, e.ApiKey, fmt.Sprint(e.RequestCount))
+				}
+				return a.print(res, t)
+			}),
+		},
+		{
+			path:    "ratelimits get",
+			args:    "<id>",
+			summary: "Show a rate limit rule",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				id, err := strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+				res, err := a.client.RateLimits.Get(ctx, id)
+				if err != nil {
+					return err
+				}
+				return a.print(res, rateLimitTable(res))
+			}),
+		},
+		{
+			path:    "ratelimits create",
+			args:    "<rule.json>",
+			summary: "Create a rate limit rule",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				body := &typesense.RateLimitRuleSchema{}
+				if err := a.readJSON(args[0], body); err != nil {
+					return err
+				}
+				res, err := a.client.RateLimits.Create(ctx, body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, rateLimitTable(res.Rule))
+			}),
+		},
+		{
+			path:    "ratelimits update",
+			args:    "<id> <rule.json>",
+			summary: "Replace a rate limit rule",
+			run: exactArgs(2, func(ctx context.Context, a *app, args []string) error {
+				id, err := strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+				body := &typesense.RateLimitRuleSchema{}
+				if err := a.readJSON(args[1], body); err != nil {
+					return err
+				}
+				res, err := a.client.RateLimits.Update(ctx, id, body)
+				if err != nil {
+					return err
+				}
+				return a.print(res, rateLimitTable(res.Rule))
+			}),
+		},
+		{
+			path:    "ratelimits delete",
+			args:    "<id>",
+			summary: "Delete a rate limit rule",
+			run: exactArgs(1, func(ctx context.Context, a *app, args []string) error {
+				id, err := strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+				res, err := a.client.RateLimits.Delete(ctx, id)
+				if err != nil {
+					return err
+				}
+				return a.print(res, nil)
+			}),
+		},
+	}
+}
+
+func rateLimitTable(rules ...*typesense.RateLimitRule) *table {
+	t := &table{header: []string{"ID", "ACTION", "API KEYS", "IP ADDRESSES", "PRIORITY"}}
+	for _, r := range rules {
+		if r == nil {
+			continue
+		}
+		t.add(fmt.Sprint(r.ID), string(r.Action), strings.Join(r.ApiKeys, ","),
+			strings.Join(r.IpAddresses, ","), num(r.Priority))
+	}
+	return t
+}
@@ -0,0 +1,97 @@
+// Command typesense-exporter exposes the metrics, stats, raft status and
+// health of every node of a Typesense cluster to Prometheus.
+//
+// Usage:
+//
+//	typesense-exporter -nodes http://node1:8108,http://node2:8108 -api-key xyz
+//
+// The nodes and API key can also be given with the TYPESENSE_NODES and
+// TYPESENSE_API_KEY environment variables. The metrics are served on
+// -listen at /metrics.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/aliml92/go-typesense/exporter"
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("typesense-exporter: ")
+
+	nodes := flag.String("nodes", os.Getenv("TYPESENSE_NODES"), "comma-separated `urls` of the cluster nodes")
+	apiKey := flag.String("api-key", os.Getenv("TYPESENSE_API_KEY"), "API key with access to the metrics")
+	listen := flag.String("listen", ":9108", "`address` to serve the metrics on")
+	interval := flag.Duration("interval", 15*time.Second, "time between two polls of the nodes")
+	timeout := flag.Duration("timeout", 5*time.Second, "timeout of the requests of one poll of a node")
+	flag.Parse()
+
+	if *nodes == "" || *apiKey == "" || flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, strings.Split(*nodes, ","), *apiKey, *listen, *interval, *timeout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, urls []string, apiKey, listen string, interval, timeout time.Duration) error {
+	var nodes []*exporter.Node
+	for _, u := range urls {
+		node, err := newNode(strings.TrimSpace(u), apiKey)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, node)
+	}
+
+	e := exporter.New(nodes...)
+	e.Interval = interval
+	e.Timeout = timeout
+	e.OnError = func(node string, err error) {
+		log.Printf("%s: %v", node, err)
+	}
+	go e.Run(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e.Handler())
+	srv := &http.Server{Addr: listen, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		srv.Shutdown(context.Background())
+	}()
+
+	log.Printf("serving metrics of %d nodes on %s/metrics", len(nodes), listen)
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func newNode(serverURL, apiKey string) (*exporter.Node, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid node url %q", serverURL)
+	}
+	client, err := typesense.NewClient(nil, serverURL, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	return &exporter.Node{Name: u.Host, Meta: client.Meta}, nil
+}
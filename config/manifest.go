@@ -0,0 +1,189 @@
+// Package config applies curation data (synonyms, overrides, presets and
+// analytics rules) kept in YAML or JSON manifests to a Typesense cluster.
+//
+// A manifest declares resources keyed by their name:
+//
+//	synonyms:
+//	  products:
+//	    coat-synonyms:
+//	      synonyms: [blazer, coat, jacket]
+//	overrides:
+//	  products:
+//	    customize-apple:
+//	      rule: {query: apple, match: exact}
+//	      includes: [{id: "422", position: 1}]
+//	presets:
+//	  listing_view:
+//	    value: {searches: [{collection: products, q: "*", sort_by: popularity}]}
+//	analytics_rules:
+//	  product_queries:
+//	    type: popular_queries
+//	    params:
+//	      source: {collections: [products]}
+//	      destination: {collection: product_queries}
+//	      limit: 1000
+//
+// Diff compares a manifest with the live cluster and returns a Plan that can
+// be printed for review and applied.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is the desired curation state of a cluster.
+type Manifest struct {
+	// Synonyms maps a collection name to its synonyms keyed by synonym id.
+	Synonyms map[string]map[string]*typesense.SearchSynonymSchema `json:"synonyms,omitempty"`
+
+	// Overrides maps a collection name to its overrides keyed by override id.
+	Overrides map[string]map[string]*typesense.SearchOverrideSchema `json:"overrides,omitempty"`
+
+	// Presets maps a preset name to its value.
+	Presets map[string]*typesense.PresetUpsertSchema `json:"presets,omitempty"`
+
+	// AnalyticsRules maps a rule name to its definition.
+	AnalyticsRules map[string]*typesense.AnalyticsRuleUpsertSchema `json:"analytics_rules,omitempty"`
+}
+
+// Parse decodes a manifest. YAML is accepted as well as JSON, since JSON is a
+// subset of YAML. Unknown keys are rejected.
+func Parse(data []byte) (*Manifest, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return &Manifest{}, nil
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	m := &Manifest{}
+	if err := dec.Decode(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Load reads and merges the manifests at paths. A directory path loads every
+// .yaml, .yml and .json file in it. Declaring the same resource in more than
+// one manifest is an error.
+func Load(paths ...string) (*Manifest, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.IsDir() || !isManifestFile(e.Name()) {
+				continue
+			}
+			files = append(files, filepath.Join(path, e.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	m := &Manifest{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		other, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if err := m.merge(other); err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+	}
+	return m, nil
+}
+
+func isManifestFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+func (m *Manifest) merge(other *Manifest) error {
+	for _, collection := range sortedKeys(other.Synonyms) {
+		synonyms := other.Synonyms[collection]
+		if m.Synonyms == nil {
+			m.Synonyms = make(map[string]map[string]*typesense.SearchSynonymSchema)
+		}
+		if m.Synonyms[collection] == nil {
+			m.Synonyms[collection] = make(map[string]*typesense.SearchSynonymSchema)
+		}
+		for _, id := range sortedKeys(synonyms) {
+			s := synonyms[id]
+			if _, ok := m.Synonyms[collection][id]; ok {
+				return fmt.Errorf("duplicate synonym %s/%s", collection, id)
+			}
+			m.Synonyms[collection][id] = s
+		}
+	}
+	for _, collection := range sortedKeys(other.Overrides) {
+		overrides := other.Overrides[collection]
+		if m.Overrides == nil {
+			m.Overrides = make(map[string]map[string]*typesense.SearchOverrideSchema)
+		}
+		if m.Overrides[collection] == nil {
+			m.Overrides[collection] = make(map[string]*typesense.SearchOverrideSchema)
+		}
+		for _, id := range sortedKeys(overrides) {
+			o := overrides[id]
+			if _, ok := m.Overrides[collection][id]; ok {
+				return fmt.Errorf("duplicate override %s/%s", collection, id)
+			}
+			m.Overrides[collection][id] = o
+		}
+	}
+	for _, name := range sortedKeys(other.Presets) {
+		p := other.Presets[name]
+		if m.Presets == nil {
+			m.Presets = make(map[string]*typesense.PresetUpsertSchema)
+		}
+		if _, ok := m.Presets[name]; ok {
+			return fmt.Errorf("duplicate preset %s", name)
+		}
+		m.Presets[name] = p
+	}
+	for _, name := range sortedKeys(other.AnalyticsRules) {
+		r := other.AnalyticsRules[name]
+		if m.AnalyticsRules == nil {
+			m.AnalyticsRules = make(map[string]*typesense.AnalyticsRuleUpsertSchema)
+		}
+		if _, ok := m.AnalyticsRules[name]; ok {
+			return fmt.Errorf("duplicate analytics rule %s", name)
+		}
+		m.AnalyticsRules[name] = r
+	}
+	return nil
+}
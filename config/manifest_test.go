@@ -0,0 +1,86 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	data := []byte(`
+analytics_rules:
+  product_queries:
+    type: popular_queries
+    params:
+      source: {collections: [products]}
+      destination: {collection: product_queries}
+      limit: 1000
+`)
+	got, err := Parse(data)
+	require.NoError(t, err)
+
+	rule := got.AnalyticsRules["product_queries"]
+	require.NotNil(t, rule)
+	assert.Equal(t, typesense.POPULAR_QUERIES_TYPE, rule.Type)
+	assert.Equal(t, []string{"products"}, rule.Params.Source.Collections)
+	assert.Equal(t, "product_queries", rule.Params.Destination.Collection)
+	assert.Equal(t, 1000, rule.Params.Limit)
+}
+
+func TestParse_UnknownField(t *testing.T) {
+	_, err := Parse([]byte(`synonym: {}`))
+	assert.Error(t, err)
+}
+
+func TestLoad(t *testing.T) {
+	got, err := Load("testdata")
+	require.NoError(t, err)
+
+	want := &Manifest{
+		Synonyms: map[string]map[string]*typesense.SearchSynonymSchema{
+			"products": {
+				"coat-synonyms": {
+					Synonyms: []string{"blazer", "coat", "jacket"},
+				},
+				"smart-phone-synonyms": {
+					Root:     typesense.String("smart phone"),
+					Synonyms: []string{"iphone", "android"},
+				},
+			},
+		},
+		Overrides: map[string]map[string]*typesense.SearchOverrideSchema{
+			"products": {
+				"customize-apple": {
+					Rule: typesense.SearchOverrideRule{
+						Query: "apple",
+						Match: typesense.Exact,
+					},
+					Includes: &[]typesense.SearchOverrideInclude{
+						{Id: "422", Position: 1},
+					},
+				},
+			},
+		},
+		Presets: map[string]*typesense.PresetUpsertSchema{
+			"listing_view": {
+				Value: map[string]interface{}{
+					"searches": []interface{}{
+						map[string]interface{}{
+							"collection": "products",
+							"q":          "*",
+							"sort_by":    "popularity",
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestLoad_Duplicate(t *testing.T) {
+	_, err := Load("testdata/synonyms.yaml", "testdata/synonyms.yaml")
+	assert.ErrorContains(t, err, "duplicate synonym products/coat-synonyms")
+}
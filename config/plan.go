@@ -0,0 +1,331 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+type Kind string
+
+const (
+	SynonymKind       Kind = "synonym"
+	OverrideKind      Kind = "override"
+	PresetKind        Kind = "preset"
+	AnalyticsRuleKind Kind = "analytics_rule"
+)
+
+// Change is a single operation needed to converge the cluster to a manifest.
+type Change struct {
+	Action Action
+	Kind   Kind
+
+	// Collection is set for synonyms and overrides.
+	Collection string
+	Name       string
+
+	// Desired is the manifest value, nil for deletes.
+	Desired interface{}
+}
+
+func (c *Change) String() string {
+	symbol := map[Action]string{Create: "+", Update: "~", Delete: "-"}[c.Action]
+	if c.Collection != "" {
+		return fmt.Sprintf("%s %s %s/%s", symbol, c.Kind, c.Collection, c.Name)
+	}
+	return fmt.Sprintf("%s %s %s", symbol, c.Kind, c.Name)
+}
+
+// Plan is the ordered list of changes produced by Diff.
+type Plan struct {
+	Changes []*Change
+}
+
+// DiffOptions controls how a manifest is compared with the cluster.
+type DiffOptions struct {
+	// Prune deletes live resources missing from the manifest. Synonyms and
+	// overrides are only pruned in collections the manifest mentions, presets
+	// and analytics rules only when the manifest declares that section.
+	Prune bool
+}
+
+// Diff compares m with the live cluster. A live resource is considered up to
+// date when every value set in the manifest matches it and the values the
+// manifest owns, such as the synonyms of a synonym or the value of a preset,
+// are not set on the live resource only. Server-side defaults omitted from
+// the manifest do not produce updates, values removed from it do.
+func Diff(ctx context.Context, client *typesense.Client, m *Manifest, opts *DiffOptions) (*Plan, error) {
+	if opts == nil {
+		opts = &DiffOptions{}
+	}
+	p := &Plan{}
+
+	for _, collection := range sortedKeys(m.Synonyms) {
+		res, err := client.Synonyms.List(ctx, collection)
+		if err != nil {
+			return nil, err
+		}
+		live := make(map[string]interface{})
+		for _, s := range res.Synonyms {
+			if s.Id != nil {
+				live[*s.Id] = s
+			}
+		}
+		desired := make(map[string]interface{})
+		for id, s := range m.Synonyms[collection] {
+			desired[id] = s
+		}
+		if err := p.diff(SynonymKind, collection, desired, live, opts.Prune); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, collection := range sortedKeys(m.Overrides) {
+		res, err := client.Overrides.List(ctx, collection)
+		if err != nil {
+			return nil, err
+		}
+		live := make(map[string]interface{})
+		for _, o := range res.Overrides {
+			if o.Id != nil {
+				live[*o.Id] = o
+			}
+		}
+		desired := make(map[string]interface{})
+		for id, o := range m.Overrides[collection] {
+			desired[id] = o
+		}
+		if err := p.diff(OverrideKind, collection, desired, live, opts.Prune); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.Presets != nil {
+		res, err := client.Presets.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		live := make(map[string]interface{})
+		for _, preset := range res.Presets {
+			live[preset.Name] = &typesense.PresetUpsertSchema{Value: preset.Value}
+		}
+		desired := make(map[string]interface{})
+		for name, preset := range m.Presets {
+			desired[name] = preset
+		}
+		if err := p.diff(PresetKind, "", desired, live, opts.Prune); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.AnalyticsRules != nil {
+		res, err := client.AnalyticsRules.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		live := make(map[string]interface{})
+		for _, rule := range res.Rules {
+			live[rule.Name] = rule
+		}
+		desired := make(map[string]interface{})
+		for name, rule := range m.AnalyticsRules {
+			desired[name] = rule
+		}
+		if err := p.diff(AnalyticsRuleKind, "", desired, live, opts.Prune); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (p *Plan) diff(kind Kind, collection string, desired, live map[string]interface{}, prune bool) error {
+	for _, name := range sortedKeys(desired) {
+		want := desired[name]
+		got, ok := live[name]
+		if !ok {
+			p.Changes = append(p.Changes, &Change{Action: Create, Kind: kind, Collection: collection, Name: name, Desired: want})
+			continue
+		}
+		same, err := matches(want, got, ownedKeys[kind])
+		if err != nil {
+			return err
+		}
+		if !same {
+			p.Changes = append(p.Changes, &Change{Action: Update, Kind: kind, Collection: collection, Name: name, Desired: want})
+		}
+	}
+	if !prune {
+		return nil
+	}
+	for _, name := range sortedKeys(live) {
+		if _, ok := desired[name]; !ok {
+			p.Changes = append(p.Changes, &Change{Action: Delete, Kind: kind, Collection: collection, Name: name})
+		}
+	}
+	return nil
+}
+
+// Empty reports whether the cluster already matches the manifest.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Fprint writes a human readable summary of the plan to w.
+func (p *Plan) Fprint(w io.Writer) error {
+	counts := make(map[Action]int)
+	for _, c := range p.Changes {
+		counts[c.Action]++
+		if _, err := fmt.Fprintln(w, c); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete.\n",
+		counts[Create], counts[Update], counts[Delete])
+	return err
+}
+
+// Apply executes the changes of the plan in order and stops at the first
+// failure.
+func (p *Plan) Apply(ctx context.Context, client *typesense.Client) error {
+	for _, c := range p.Changes {
+		if err := apply(ctx, client, c); err != nil {
+			return fmt.Errorf("%s %s: %w", c.Action, c.Kind, err)
+		}
+	}
+	return nil
+}
+
+func apply(ctx context.Context, client *typesense.Client, c *Change) error {
+	var err error
+	switch c.Kind {
+	case SynonymKind:
+		if c.Action == Delete {
+			_, err = client.Synonyms.Delete(ctx, c.Collection, c.Name)
+		} else {
+			_, err = client.Synonyms.Upsert(ctx, c.Collection, c.Name, c.Desired.(*typesense.SearchSynonymSchema))
+		}
+	case OverrideKind:
+		if c.Action == Delete {
+			_, err = client.Overrides.Delete(ctx, c.Collection, c.Name)
+		} else {
+			_, err = client.Overrides.Upsert(ctx, c.Collection, c.Name, c.Desired.(*typesense.SearchOverrideSchema))
+		}
+	case PresetKind:
+		if c.Action == Delete {
+			_, err = client.Presets.Delete(ctx, c.Name)
+		} else {
+			_, err = client.Presets.Upsert(ctx, c.Name, c.Desired.(*typesense.PresetUpsertSchema))
+		}
+	case AnalyticsRuleKind:
+		switch c.Action {
+		case Create:
+			rule := c.Desired.(*typesense.AnalyticsRuleUpsertSchema)
+			_, err = client.AnalyticsRules.Create(ctx, &typesense.AnalyticsRule{
+				Name:   c.Name,
+				Type:   rule.Type,
+				Params: rule.Params,
+			})
+		case Update:
+			_, err = client.AnalyticsRules.Upsert(ctx, c.Name, c.Desired.(*typesense.AnalyticsRuleUpsertSchema))
+		case Delete:
+			_, err = client.AnalyticsRules.Delete(ctx, c.Name)
+		}
+	default:
+		err = fmt.Errorf("unknown kind %q", c.Kind)
+	}
+	return err
+}
+
+// ownedKeys are the keys of each kind that are defined by the manifest alone:
+// a live resource setting one of them differently, including setting one the
+// manifest omits, is out of date. Other keys may carry server-side defaults,
+// e.g. the limit and expand_query params of analytics rules.
+var ownedKeys = map[Kind][]string{
+	SynonymKind:       {"root", "synonyms"},
+	OverrideKind:      {"rule", "includes", "excludes", "filter_by"},
+	PresetKind:        {"value"},
+	AnalyticsRuleKind: {"type"},
+}
+
+// matches reports whether every value set in want is equal in got and the
+// owned keys of both are equal, comparing their JSON representations.
+func matches(want, got interface{}, owned []string) (bool, error) {
+	w, err := toJSONValue(want)
+	if err != nil {
+		return false, err
+	}
+	g, err := toJSONValue(got)
+	if err != nil {
+		return false, err
+	}
+	wm, _ := w.(map[string]interface{})
+	gm, _ := g.(map[string]interface{})
+	for _, k := range owned {
+		if !reflect.DeepEqual(wm[k], gm[k]) {
+			return false, nil
+		}
+	}
+	return subset(w, g), nil
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	err = json.Unmarshal(b, &res)
+	return res, err
+}
+
+func subset(want, got interface{}) bool {
+	switch want := want.(type) {
+	case map[string]interface{}:
+		got, ok := got.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range want {
+			if !subset(v, got[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		got, ok := got.([]interface{})
+		if !ok || len(want) != len(got) {
+			return false
+		}
+		for i := range want {
+			if !subset(want[i], got[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(want, got)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,203 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) (*typesense.Client, *http.ServeMux) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := typesense.NewClient(nil, server.URL, "xyz")
+	require.NoError(t, err)
+	return client, mux
+}
+
+func TestDiffAndApply(t *testing.T) {
+	client, mux := setup(t)
+
+	var calls []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{}`)
+	}
+
+	mux.HandleFunc("/collections/products/synonyms", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"synonyms": [
+			{"id": "coat-synonyms", "synonyms": ["blazer", "coat", "jacket"]},
+			{"id": "smart-phone-synonyms", "root": "smart phone", "synonyms": ["iphone"]},
+			{"id": "stale", "synonyms": ["a", "b"]}
+		]}`)
+	})
+	mux.HandleFunc("/collections/products/synonyms/", record)
+	mux.HandleFunc("/collections/products/overrides", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"overrides": [
+			{"id": "customize-apple", "rule": {"query": "apple", "match": "exact"},
+			 "includes": [{"id": "422", "position": 1}], "stop_processing": true}
+		]}`)
+	})
+	mux.HandleFunc("/collections/products/overrides/", record)
+	mux.HandleFunc("/presets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"presets": []}`)
+	})
+	mux.HandleFunc("/presets/", record)
+
+	m, err := Load("testdata")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	plan, err := Diff(ctx, client, m, &DiffOptions{Prune: true})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, plan.Fprint(&buf))
+	assert.Equal(t, `~ synonym products/smart-phone-synonyms
+- synonym products/stale
++ preset listing_view
+Plan: 1 to create, 1 to update, 1 to delete.
+`, buf.String())
+
+	require.NoError(t, plan.Apply(ctx, client))
+	assert.Equal(t, []string{
+		"PUT /collections/products/synonyms/smart-phone-synonyms",
+		"DELETE /collections/products/synonyms/stale",
+		"PUT /presets/listing_view",
+	}, calls)
+}
+
+func TestDiff_NoPrune(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/analytics/rules", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rules": [
+			{"name": "product_queries", "type": "popular_queries", "params": {
+				"source": {"collections": ["products"]},
+				"destination": {"collection": "product_queries"},
+				"limit": 1000
+			}},
+			{"name": "other", "type": "popular_queries", "params": {}}
+		]}`)
+	})
+
+	m, err := Parse([]byte(`
+analytics_rules:
+  product_queries:
+    type: popular_queries
+    params:
+      source: {collections: [products]}
+      destination: {collection: product_queries}
+      limit: 1000
+`))
+	require.NoError(t, err)
+
+	plan, err := Diff(context.Background(), client, m, nil)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty())
+}
+
+func TestDiff_AnalyticsRuleDefaults(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/analytics/rules", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rules": [
+			{"name": "product_queries", "type": "popular_queries", "params": {
+				"source": {"collections": ["products"]},
+				"destination": {"collection": "product_queries"},
+				"limit": 1000,
+				"expand_query": false
+			}},
+			{"name": "no_hits", "type": "nohits_queries", "params": {
+				"source": {"collections": ["products"]},
+				"destination": {"collection": "old_no_hits"},
+				"limit": 1000
+			}}
+		]}`)
+	})
+
+	m, err := Parse([]byte(`
+analytics_rules:
+  product_queries:
+    type: popular_queries
+    params:
+      source: {collections: [products]}
+      destination: {collection: product_queries}
+  no_hits:
+    type: nohits_queries
+    params:
+      source: {collections: [products]}
+      destination: {collection: no_hits}
+`))
+	require.NoError(t, err)
+
+	plan, err := Diff(context.Background(), client, m, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, plan.Fprint(&buf))
+	assert.Equal(t, `~ analytics_rule no_hits
+Plan: 0 to create, 1 to update, 0 to delete.
+`, buf.String())
+}
+
+func TestDiff_RemovedFields(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/collections/products/synonyms", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"synonyms": [
+			{"id": "coat-synonyms", "root": "coat", "synonyms": ["blazer", "jacket"]},
+			{"id": "phone-synonyms", "synonyms": ["iphone", "android"]}
+		]}`)
+	})
+	mux.HandleFunc("/collections/products/overrides", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"overrides": [
+			{"id": "customize-apple", "rule": {"query": "apple", "match": "exact"},
+			 "filter_by": "brand:apple", "remove_matched_tokens": true}
+		]}`)
+	})
+	mux.HandleFunc("/presets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"presets": [
+			{"name": "listing_view", "value": {"q": "*", "sort_by": "popularity:desc"}},
+			{"name": "search_view", "value": {"q": "*", "query_by": "name"}}
+		]}`)
+	})
+
+	m, err := Parse([]byte(`
+synonyms:
+  products:
+    coat-synonyms:
+      synonyms: [blazer, jacket]
+    phone-synonyms:
+      synonyms: [iphone, android]
+overrides:
+  products:
+    customize-apple:
+      rule: {query: apple, match: exact}
+presets:
+  listing_view:
+    value: {q: "*"}
+  search_view:
+    value: {q: "*", query_by: name}
+`))
+	require.NoError(t, err)
+
+	plan, err := Diff(context.Background(), client, m, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, plan.Fprint(&buf))
+	assert.Equal(t, `~ synonym products/coat-synonyms
+~ override products/customize-apple
+~ preset listing_view
+Plan: 0 to create, 3 to update, 0 to delete.
+`, buf.String())
+}
@@ -0,0 +1,345 @@
+// Package exporter exposes the metrics, stats, raft status and health of the
+// nodes of a Typesense cluster as Prometheus gauges.
+//
+// An Exporter polls every node in the background and serves the latest values,
+// so scrapes by Prometheus never wait for the cluster:
+//
+//	e := exporter.New(
+//		&exporter.Node{Name: "node1:8108", Meta: node1.Meta},
+//		&exporter.Node{Name: "node2:8108", Meta: node2.Meta},
+//	)
+//	go e.Run(ctx)
+//	http.Handle("/metrics", e.Handler())
+package exporter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	defaultInterval = 15 * time.Second
+	defaultTimeout  = 5 * time.Second
+)
+
+// RaftStates are the values of the state label of the typesense_raft_state
+// gauge. The gauge is 1 for the node's current state and 0 for the others.
+var RaftStates = []typesense.RaftState{
+	typesense.RaftLeader,
+	typesense.RaftFollower,
+	typesense.RaftCandidate,
+	typesense.RaftError,
+}
+
+// Node is a node of the cluster.
+type Node struct {
+	// Name is the value of the node label, e.g. the node's host:port.
+	Name string
+
+	// Meta is the MetaService of a client connected to the node only.
+	Meta typesense.MetaAPI
+}
+
+// Exporter polls the nodes of a cluster and holds their latest values. It
+// implements prometheus.Collector.
+type Exporter struct {
+	nodes []*Node
+
+	// Interval is the time between two polls of the nodes.
+	// Default: 15s
+	Interval time.Duration
+
+	// Timeout bounds the requests of one poll of a node.
+	// Default: 5s
+	Timeout time.Duration
+
+	// OnError, if set, is called with the errors of the requests to a node.
+	OnError func(node string, err error)
+
+	collectors []prometheus.Collector
+
+	up            *prometheus.GaugeVec
+	healthy       *prometheus.GaugeVec
+	requestErrors *prometheus.CounterVec
+
+	metrics         map[string]*prometheus.GaugeVec
+	cpu             *prometheus.GaugeVec
+	stats           map[string]*prometheus.GaugeVec
+	endpointLatency *prometheus.GaugeVec
+	endpointRate    *prometheus.GaugeVec
+
+	raftState      *prometheus.GaugeVec
+	queuedWrites   *prometheus.GaugeVec
+	committedIndex *prometheus.GaugeVec
+}
+
+func New(nodes ...*Node) *Exporter {
+	e := &Exporter{
+		nodes:    nodes,
+		Interval: defaultInterval,
+		Timeout:  defaultTimeout,
+	}
+
+	e.up = e.gauge("typesense_up", "Whether the node responded to the last poll.")
+	e.healthy = e.gauge("typesense_healthy", "Whether the node reported itself healthy.")
+	e.requestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typesense_exporter_errors_total",
+		Help: "Number of failed requests to the node.",
+	}, []string{"node", "endpoint"})
+	e.collectors = append(e.collectors, e.requestErrors)
+
+	e.metrics = make(map[string]*prometheus.GaugeVec)
+	for name, help := range map[string]string{
+		"system_cpu_active_percentage":  "Active percentage of all CPUs.",
+		"system_disk_total_bytes":       "Size of the disk holding the data directory.",
+		"system_disk_used_bytes":        "Used space of the disk holding the data directory.",
+		"system_memory_total_bytes":     "Total system memory.",
+		"system_memory_used_bytes":      "Used system memory.",
+		"system_network_received_bytes": "Bytes received over the network.",
+		"system_network_sent_bytes":     "Bytes sent over the network.",
+		"memory_active_bytes":           "Memory in active pages of the allocator.",
+		"memory_allocated_bytes":        "Memory allocated by Typesense.",
+		"memory_fragmentation_ratio":    "Fraction of active memory not allocated.",
+		"memory_mapped_bytes":           "Memory mapped by the allocator.",
+		"memory_metadata_bytes":         "Memory used by allocator metadata.",
+		"memory_resident_bytes":         "Resident memory of the allocator.",
+		"memory_retained_bytes":         "Memory retained by the allocator.",
+	} {
+		e.metrics[name] = e.gauge("typesense_"+name, help)
+	}
+	e.cpu = e.gauge("typesense_system_cpu_individual_active_percentage", "Active percentage of each CPU.", "cpu")
+
+	e.stats = make(map[string]*prometheus.GaugeVec)
+	for name, help := range map[string]string{
+		"search_latency_ms":              "Average latency of search requests.",
+		"search_requests_per_second":     "Rate of search requests.",
+		"write_latency_ms":               "Average latency of write requests.",
+		"write_requests_per_second":      "Rate of write requests.",
+		"import_latency_ms":              "Average latency of import requests.",
+		"import_requests_per_second":     "Rate of import requests.",
+		"delete_latency_ms":              "Average latency of delete requests.",
+		"delete_requests_per_second":     "Rate of delete requests.",
+		"total_requests_per_second":      "Rate of all requests.",
+		"overloaded_requests_per_second": "Rate of requests rejected because the node is overloaded.",
+		"pending_write_batches":          "Number of write batches waiting to be applied.",
+	} {
+		e.stats[name] = e.gauge("typesense_"+name, help)
+	}
+	e.endpointLatency = e.gauge("typesense_endpoint_latency_ms", "Average latency of requests to the endpoint.", "endpoint")
+	e.endpointRate = e.gauge("typesense_endpoint_requests_per_second", "Rate of requests to the endpoint.", "endpoint")
+
+	e.raftState = e.gauge("typesense_raft_state", "Raft state of the node.", "state")
+	e.queuedWrites = e.gauge("typesense_queued_writes", "Number of writes queued on the node.")
+	e.committedIndex = e.gauge("typesense_committed_index", "Raft index committed on the node.")
+	return e
+}
+
+func (e *Exporter) gauge(name, help string, labels ...string) *prometheus.GaugeVec {
+	g := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, append([]string{"node"}, labels...))
+	e.collectors = append(e.collectors, g)
+	return g
+}
+
+// Describe implements prometheus.Collector.
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range e.collectors {
+		c.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range e.collectors {
+		c.Collect(ch)
+	}
+}
+
+// Handler returns an http.Handler serving the values of the exporter only in
+// the Prometheus exposition format.
+func (e *Exporter) Handler() http.Handler {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(e)
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
+}
+
+// Run polls the nodes every Interval until ctx is done, starting right away.
+func (e *Exporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+	for {
+		e.Poll(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll queries all nodes concurrently and updates the gauges.
+func (e *Exporter) Poll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, n := range e.nodes {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, e.Timeout)
+			defer cancel()
+			e.poll(ctx, n)
+		}(n)
+	}
+	wg.Wait()
+}
+
+func (e *Exporter) poll(ctx context.Context, n *Node) {
+	node := prometheus.Labels{"node": n.Name}
+	reachable := false
+
+	health, err := n.Meta.Health(ctx)
+	var apiErr *typesense.ApiError
+	switch {
+	case err == nil:
+		reachable = true
+		e.healthy.With(node).Set(boolValue(health.Ok))
+	case errors.As(err, &apiErr):
+		// an unhealthy node answers /health with 503
+		reachable = true
+		e.healthy.With(node).Set(0)
+	default:
+		e.fail(n, "health", err)
+		e.healthy.With(node).Set(0)
+	}
+
+	// the values of a failed request are removed rather than left at their
+	// last value, which would be exported as current
+	if status, err := n.Meta.Status(ctx); err != nil {
+		e.fail(n, "status", err)
+		e.clear(n.Name, e.raftState, e.queuedWrites, e.committedIndex)
+	} else {
+		reachable = true
+		e.clear(n.Name, e.raftState)
+		for _, state := range RaftStates {
+			e.raftState.WithLabelValues(n.Name, state.String()).Set(boolValue(state == status.State))
+		}
+		if !contains(RaftStates, status.State) {
+			e.raftState.WithLabelValues(n.Name, status.State.String()).Set(1)
+		}
+		e.queuedWrites.With(node).Set(float64(status.QueuedWrites))
+		e.committedIndex.With(node).Set(float64(status.CommittedIndex))
+	}
+
+	if m, err := n.Meta.Metrics(ctx); err != nil {
+		e.fail(n, "metrics", err)
+		e.clear(n.Name, e.cpu)
+		for _, g := range e.metrics {
+			e.clear(n.Name, g)
+		}
+	} else {
+		reachable = true
+		e.setMetrics(n.Name, m)
+	}
+
+	if s, err := n.Meta.Stats(ctx); err != nil {
+		e.fail(n, "stats", err)
+		e.clear(n.Name, e.endpointLatency, e.endpointRate)
+		for _, g := range e.stats {
+			e.clear(n.Name, g)
+		}
+	} else {
+		reachable = true
+		e.setStats(n.Name, s)
+	}
+
+	e.up.With(node).Set(boolValue(reachable))
+}
+
+func (e *Exporter) setMetrics(node string, m *typesense.Metrics) {
+	for name, v := range map[string]float64{
+		"system_cpu_active_percentage":  m.SystemCPUActivePercentage,
+		"system_disk_total_bytes":       float64(m.SystemDiskTotalBytes),
+		"system_disk_used_bytes":        float64(m.SystemDiskUsedBytes),
+		"system_memory_total_bytes":     float64(m.SystemMemoryTotalBytes),
+		"system_memory_used_bytes":      float64(m.SystemMemoryUsedBytes),
+		"system_network_received_bytes": float64(m.SystemNetworkReceivedBytes),
+		"system_network_sent_bytes":     float64(m.SystemNetworkSentBytes),
+		"memory_active_bytes":           float64(m.TypesenseMemoryActiveBytes),
+		"memory_allocated_bytes":        float64(m.TypesenseMemoryAllocatedBytes),
+		"memory_fragmentation_ratio":    m.TypesenseMemoryFragmentationRatio,
+		"memory_mapped_bytes":           float64(m.TypesenseMemoryMappedBytes),
+		"memory_metadata_bytes":         float64(m.TypesenseMemoryMetadataBytes),
+		"memory_resident_bytes":         float64(m.TypesenseMemoryResidentBytes),
+		"memory_retained_bytes":         float64(m.TypesenseMemoryRetainedBytes),
+	} {
+		e.metrics[name].WithLabelValues(node).Set(v)
+	}
+
+	e.clear(node, e.cpu)
+	for _, c := range m.SystemCPUIndividualPercentage {
+		e.cpu.WithLabelValues(node, strconv.Itoa(c.CPU)).Set(c.Percentage)
+	}
+}
+
+func (e *Exporter) setStats(node string, s *typesense.Stats) {
+	for name, v := range map[string]float32{
+		"search_latency_ms":              s.SearchLatencyMS,
+		"search_requests_per_second":     s.SearchRequestsPerSecond,
+		"write_latency_ms":               s.WriteLatencyMS,
+		"write_requests_per_second":      s.WriteRequestsPerSecond,
+		"import_latency_ms":              s.ImportLatencyMS,
+		"import_requests_per_second":     s.ImportRequestsPerSecond,
+		"delete_latency_ms":              s.DeleteLatencyMS,
+		"delete_requests_per_second":     s.DeleteRequestsPerSecond,
+		"total_requests_per_second":      s.TotalRequestsPerSecond,
+		"overloaded_requests_per_second": s.OverloadedRequestsPerSecond,
+		"pending_write_batches":          s.PendingWriteBatches,
+	} {
+		e.stats[name].WithLabelValues(node).Set(float64(v))
+	}
+
+	// endpoints without recent requests disappear from the stats
+	e.clear(node, e.endpointLatency, e.endpointRate)
+	for _, l := range s.TopLatencies(0) {
+		e.endpointLatency.WithLabelValues(node, l.Endpoint).Set(float64(l.LatencyMS))
+	}
+	for endpoint, rate := range s.RequestsPerSecond {
+		e.endpointRate.WithLabelValues(node, endpoint).Set(float64(rate))
+	}
+}
+
+// clear removes the values of node from gauges.
+func (e *Exporter) clear(node string, gauges ...*prometheus.GaugeVec) {
+	for _, g := range gauges {
+		g.DeletePartialMatch(prometheus.Labels{"node": node})
+	}
+}
+
+func (e *Exporter) fail(n *Node, endpoint string, err error) {
+	e.requestErrors.WithLabelValues(n.Name, endpoint).Inc()
+	if e.OnError != nil {
+		e.OnError(n.Name, err)
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func contains(list []typesense.RaftState, s typesense.RaftState) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
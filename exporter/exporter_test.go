@@ -0,0 +1,120 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/aliml92/go-typesense/typesense/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	leader := mocks.NewMockMetaAPI(ctrl)
+	leader.EXPECT().Health(gomock.Any()).Return(&typesense.HealthStatus{Ok: true}, nil)
+	leader.EXPECT().Status(gomock.Any()).Return(&typesense.NodeStatus{State: typesense.RaftLeader, QueuedWrites: 3, CommittedIndex: 42}, nil)
+	leader.EXPECT().Metrics(gomock.Any()).Return(&typesense.Metrics{
+		SystemMemoryUsedBytes:             6258020352,
+		TypesenseMemoryFragmentationRatio: 0.11,
+		SystemCPUIndividualPercentage:     []typesense.CPUPercentage{{CPU: 1, Percentage: 30.77}},
+	}, nil)
+	leader.EXPECT().Stats(gomock.Any()).Return(&typesense.Stats{
+		SearchLatencyMS:   2.5,
+		LatencyMS:         map[string]float32{"GET /collections/products/documents/search": 2.5},
+		RequestsPerSecond: map[string]float32{"GET /collections/products/documents/search": 10},
+	}, nil)
+
+	unreachable := errors.New("connection refused")
+	down := mocks.NewMockMetaAPI(ctrl)
+	down.EXPECT().Health(gomock.Any()).Return(nil, unreachable)
+	down.EXPECT().Status(gomock.Any()).Return(nil, unreachable)
+	down.EXPECT().Metrics(gomock.Any()).Return(nil, unreachable)
+	down.EXPECT().Stats(gomock.Any()).Return(nil, unreachable)
+
+	var failures []string
+	e := New(&Node{Name: "node1:8108", Meta: leader}, &Node{Name: "node2:8108", Meta: down})
+	e.OnError = func(node string, err error) { failures = append(failures, node) }
+	e.Poll(context.Background())
+
+	assert.Equal(t, []string{"node2:8108", "node2:8108", "node2:8108", "node2:8108"}, failures)
+	assert.Equal(t, 1.0, testutil.ToFloat64(e.up.WithLabelValues("node1:8108")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(e.up.WithLabelValues("node2:8108")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(e.raftState.WithLabelValues("node1:8108", "LEADER")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(e.raftState.WithLabelValues("node1:8108", "FOLLOWER")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(e.requestErrors.WithLabelValues("node2:8108", "stats")))
+
+	rec := httptest.NewRecorder()
+	e.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+	for _, line := range []string{
+		`typesense_queued_writes{node="node1:8108"} 3`,
+		`typesense_committed_index{node="node1:8108"} 42`,
+		`typesense_healthy{node="node1:8108"} 1`,
+		`typesense_system_memory_used_bytes{node="node1:8108"} 6.258020352e+09`,
+		`typesense_memory_fragmentation_ratio{node="node1:8108"} 0.11`,
+		`typesense_system_cpu_individual_active_percentage{cpu="1",node="node1:8108"} 30.77`,
+		`typesense_search_latency_ms{node="node1:8108"} 2.5`,
+		`typesense_endpoint_latency_ms{endpoint="GET /collections/products/documents/search",node="node1:8108"} 2.5`,
+		`typesense_endpoint_requests_per_second{endpoint="GET /collections/products/documents/search",node="node1:8108"} 10`,
+		`typesense_exporter_errors_total{endpoint="metrics",node="node2:8108"} 1`,
+	} {
+		assert.Contains(t, string(body), line+"\n")
+	}
+	require.False(t, strings.Contains(string(body), `typesense_queued_writes{node="node2:8108"}`))
+}
+
+func TestExporter_FailedPollClearsValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	unreachable := errors.New("connection refused")
+	node := mocks.NewMockMetaAPI(ctrl)
+	gomock.InOrder(
+		node.EXPECT().Status(gomock.Any()).Return(&typesense.NodeStatus{State: typesense.RaftLeader, QueuedWrites: 3, CommittedIndex: 42}, nil),
+		node.EXPECT().Status(gomock.Any()).Return(nil, unreachable),
+	)
+	gomock.InOrder(
+		node.EXPECT().Metrics(gomock.Any()).Return(&typesense.Metrics{
+			SystemMemoryUsedBytes:         6258020352,
+			SystemCPUIndividualPercentage: []typesense.CPUPercentage{{CPU: 1, Percentage: 30.77}},
+		}, nil),
+		node.EXPECT().Metrics(gomock.Any()).Return(nil, unreachable),
+	)
+	gomock.InOrder(
+		node.EXPECT().Stats(gomock.Any()).Return(&typesense.Stats{
+			SearchLatencyMS:   2.5,
+			RequestsPerSecond: map[string]float32{"GET /collections/products/documents/search": 10},
+		}, nil),
+		node.EXPECT().Stats(gomock.Any()).Return(nil, unreachable),
+	)
+	node.EXPECT().Health(gomock.Any()).Return(&typesense.HealthStatus{Ok: true}, nil).Times(2)
+
+	e := New(&Node{Name: "node1:8108", Meta: node})
+	e.Poll(context.Background())
+	assert.Equal(t, 1, testutil.CollectAndCount(e.queuedWrites))
+	assert.Equal(t, 1, testutil.CollectAndCount(e.cpu))
+	assert.Equal(t, 1, testutil.CollectAndCount(e.endpointRate))
+
+	e.Poll(context.Background())
+	assert.Equal(t, 1.0, testutil.ToFloat64(e.up.WithLabelValues("node1:8108")))
+	for name, g := range map[string]*prometheus.GaugeVec{
+		"raft_state":                e.raftState,
+		"queued_writes":             e.queuedWrites,
+		"committed_index":           e.committedIndex,
+		"system_memory_used_bytes":  e.metrics["system_memory_used_bytes"],
+		"cpu":                       e.cpu,
+		"search_latency_ms":         e.stats["search_latency_ms"],
+		"endpoint_requests_per_sec": e.endpointRate,
+	} {
+		assert.Equal(t, 0, testutil.CollectAndCount(g), name)
+	}
+}
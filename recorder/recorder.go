@@ -0,0 +1,333 @@
+// Package recorder provides an http.RoundTripper that records interactions
+// with a Typesense server to a golden file and replays them later, so tests
+// can run deterministically without a server:
+//
+//	rec, err := recorder.New("testdata/search.json", recorder.ModeAuto)
+//	...
+//	defer rec.Close()
+//	client, err := typesense.NewClient(rec.Client(), "http://localhost:8108", apiKey)
+//
+// The API key header and query parameter are redacted before interactions
+// are written.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether a Recorder talks to the server or to the golden file.
+type Mode int
+
+const (
+	// ModeReplay serves requests from the golden file only.
+	ModeReplay Mode = iota
+	// ModeRecord forwards requests to the server and writes the golden file
+	// on Close, replacing any existing one.
+	ModeRecord
+	// ModeAuto replays if the golden file exists and records otherwise.
+	ModeAuto
+)
+
+// Match selects the parts of a request that must equal a recorded request for
+// it to be replayed.
+type Match int
+
+const (
+	MatchMethod Match = 1 << iota
+	MatchPath
+	MatchQuery
+	// MatchBody compares JSON bodies semantically and other bodies byte for
+	// byte.
+	MatchBody
+
+	MatchAll = MatchMethod | MatchPath | MatchQuery | MatchBody
+)
+
+const (
+	headerAPIKey = "X-Typesense-Api-Key"
+	queryAPIKey  = "x-typesense-api-key"
+
+	// Redacted replaces the API key in recorded requests.
+	Redacted = "REDACTED"
+)
+
+// ErrNoInteraction is returned in replay mode when no unused recorded
+// interaction matches a request.
+var ErrNoInteraction = errors.New("recorder: no matching interaction")
+
+// Cassette is the content of a golden file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording to or replaying from a golden
+// file.
+type Recorder struct {
+	// Transport sends requests in record mode. If nil, http.DefaultTransport
+	// is used.
+	Transport http.RoundTripper
+
+	// Match selects how requests are matched in replay mode. If zero,
+	// MatchAll is used.
+	Match Match
+
+	path      string
+	recording bool
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New returns a Recorder for the golden file at path. In replay mode the file
+// is loaded immediately.
+func New(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, cassette: &Cassette{}}
+
+	switch mode {
+	case ModeRecord:
+		r.recording = true
+		return r, nil
+	case ModeAuto:
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			r.recording = true
+			return r, nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, r.cassette); err != nil {
+		return nil, fmt.Errorf("recorder: %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Recording reports whether the Recorder forwards requests to the server.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// Client returns an HTTP client using the Recorder as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if r.recording {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: &Request{
+			Method: req.Method,
+			URL:    redactURL(req.URL),
+			Header: redactHeader(req.Header),
+			Body:   string(body),
+		},
+		Response: &Response{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       string(respBody),
+		},
+	})
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !r.matches(req, body, in.Request) {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w for %s %s", ErrNoInteraction, req.Method, redactURL(req.URL))
+}
+
+func (r *Recorder) matches(req *http.Request, body []byte, rec *Request) bool {
+	match := r.Match
+	if match == 0 {
+		match = MatchAll
+	}
+	u, err := url.Parse(rec.URL)
+	if err != nil {
+		return false
+	}
+
+	if match&MatchMethod != 0 && req.Method != rec.Method {
+		return false
+	}
+	if match&MatchPath != 0 && req.URL.Path != u.Path {
+		return false
+	}
+	if match&MatchQuery != 0 && !equalQuery(req.URL.Query(), u.Query()) {
+		return false
+	}
+	if match&MatchBody != 0 && !equalBody(body, []byte(rec.Body)) {
+		return false
+	}
+	return true
+}
+
+// Close writes the golden file in record mode. In replay mode it returns an
+// error listing the recorded interactions that were never replayed.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.recording {
+		var unused []string
+		for i, in := range r.cassette.Interactions {
+			if !r.used[i] {
+				unused = append(unused, in.Request.Method+" "+in.Request.URL)
+			}
+		}
+		if len(unused) > 0 {
+			return fmt.Errorf("recorder: %d interactions not replayed: %s", len(unused), strings.Join(unused, ", "))
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	if h.Get(headerAPIKey) != "" {
+		h.Set(headerAPIKey, Redacted)
+	}
+	return h
+}
+
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	if q.Has(queryAPIKey) {
+		q.Set(queryAPIKey, Redacted)
+	}
+	res := *u
+	res.RawQuery = q.Encode()
+	return res.String()
+}
+
+func equalQuery(a, b url.Values) bool {
+	a.Del(queryAPIKey)
+	b.Del(queryAPIKey)
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		w := b[k]
+		if len(v) != len(w) {
+			return false
+		}
+		v, w = append([]string(nil), v...), append([]string(nil), w...)
+		sort.Strings(v)
+		sort.Strings(w)
+		if !reflect.DeepEqual(v, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalBody(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var x, y interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,135 @@
+package recorder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKey = "secret-key"
+
+func server(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/collections/companies/documents/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"found": 1, "hits": [{"document": {"id": "1", "q": %q}}]}`, r.URL.Query().Get("q"))
+	})
+	mux.HandleFunc("/collections/companies/documents", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(body)
+	})
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func record(t *testing.T, path string) string {
+	s := server(t)
+	rec, err := New(path, ModeRecord)
+	require.NoError(t, err)
+	require.True(t, rec.Recording())
+
+	client, err := typesense.NewClient(rec.Client(), s.URL, apiKey)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = client.Documents.Search(ctx, "companies", &typesense.SearchParameters{Q: "stark", QueryBy: "company_name"})
+	require.NoError(t, err)
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "1", "company_name": "Stark"})
+	require.NoError(t, err)
+
+	require.NoError(t, rec.Close())
+	return s.URL
+}
+
+func TestRecordReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "companies.json")
+	serverURL := record(t, path)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), apiKey)
+	assert.Contains(t, string(data), Redacted)
+
+	rec, err := New(path, ModeAuto)
+	require.NoError(t, err)
+	require.False(t, rec.Recording())
+
+	client, err := typesense.NewClient(rec.Client(), serverURL, "another-key")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	doc, err := client.Documents.Create(ctx, "companies", map[string]interface{}{"company_name": "Stark", "id": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "Stark", doc.(map[string]interface{})["company_name"])
+
+	res, err := client.Documents.Search(ctx, "companies", &typesense.SearchParameters{QueryBy: "company_name", Q: "stark"})
+	require.NoError(t, err)
+	assert.Equal(t, "stark", res.Hits[0].Document["q"])
+
+	require.NoError(t, rec.Close())
+}
+
+func TestReplay_NoInteraction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "companies.json")
+	serverURL := record(t, path)
+
+	rec, err := New(path, ModeReplay)
+	require.NoError(t, err)
+	client, err := typesense.NewClient(rec.Client(), serverURL, apiKey)
+	require.NoError(t, err)
+
+	_, err = client.Documents.Search(context.Background(), "companies", &typesense.SearchParameters{Q: "orbit", QueryBy: "company_name"})
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+
+	err = rec.Close()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 interactions not replayed")
+}
+
+func TestReplay_Match(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "companies.json")
+	serverURL := record(t, path)
+
+	rec, err := New(path, ModeReplay)
+	require.NoError(t, err)
+	rec.Match = MatchMethod | MatchPath
+
+	client, err := typesense.NewClient(rec.Client(), serverURL, apiKey)
+	require.NoError(t, err)
+	res, err := client.Documents.Search(context.Background(), "companies", &typesense.SearchParameters{Q: "orbit", QueryBy: "company_name"})
+	require.NoError(t, err)
+	assert.Equal(t, "stark", res.Hits[0].Document["q"])
+}
+
+func TestNew_MissingFile(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	assert.Error(t, err)
+
+	rec, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeAuto)
+	require.NoError(t, err)
+	assert.True(t, rec.Recording())
+}
+
+func TestEqualBody(t *testing.T) {
+	assert.True(t, equalBody([]byte(`{"a": 1, "b": [1, 2]}`), []byte(`{"b":[1,2],"a":1}`)))
+	assert.False(t, equalBody([]byte(`{"a": 1}`), []byte(`{"a": 2}`)))
+	assert.True(t, equalBody(nil, nil))
+	assert.False(t, equalBody([]byte("a\nb"), []byte("a\nc")))
+}
+
+func TestRedactURL(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8108/health?x-typesense-api-key=abc", nil)
+	assert.True(t, strings.HasSuffix(redactURL(req.URL), "x-typesense-api-key="+Redacted))
+}
@@ -16,7 +16,7 @@ func TestRateLimits(t *testing.T) {
 
 	// Create a key
 	keySchema := &typesense.ApiKeySchema{
-		Actions:     []string{"*"},
+		Actions:     []typesense.KeyAction{"*"},
 		Collections: []string{"*"},
 		Description: typesense.String("a user key"),
 		Value:       typesense.String("abc"),
@@ -1,7 +1,9 @@
 package typesense
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -14,7 +16,7 @@ func (s *AliasesService) List(ctx context.Context) ([]*CollectionAlias, error) {
 		return nil, err
 	}
 
-	var res []*CollectionAlias
+	var res aliasList
 	err = s.client.Do(ctx, req, &res)
 	if err != nil {
 		return nil, err
@@ -22,6 +24,22 @@ func (s *AliasesService) List(ctx context.Context) ([]*CollectionAlias, error) {
 	return res, nil
 }
 
+// aliasList decodes both the `{"aliases": [...]}` object returned by the
+// server and a bare list of aliases.
+type aliasList []*CollectionAlias
+
+func (l *aliasList) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return json.Unmarshal(data, (*[]*CollectionAlias)(l))
+	}
+	var res CollectionAliasesResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	*l = res.Aliases
+	return nil
+}
+
 func (s *AliasesService) Upsert(ctx context.Context, aliasName string, body *CollectionAliasSchema) (*CollectionAlias, error) {
 	u := fmt.Sprintf("/aliases/%s", aliasName)
 	req, err := s.client.NewRequest("PUT", u, body)
@@ -34,6 +34,33 @@ func TestAliasesService_List(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestAliasesService_ListResponseObject(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/aliases", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{
+		"aliases": [
+			{
+				"collection_name": "companies",
+				"name": "companies_alias"
+			}
+		]
+	  }`)
+	})
+
+	want := []*CollectionAlias{{
+		CollectionName: "companies",
+		Name:           "companies_alias",
+	}}
+
+	got, err := client.Aliases.List(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
 func TestAliasesService_Upsert(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -4,14 +4,67 @@ import (
 	"context"
 )
 
+type AnalyticsEventType string
+
+const (
+	SEARCH_EVENT_TYPE     AnalyticsEventType = "search"
+	CLICK_EVENT_TYPE      AnalyticsEventType = "click"
+	CONVERSION_EVENT_TYPE AnalyticsEventType = "conversion"
+	VISIT_EVENT_TYPE      AnalyticsEventType = "visit"
+	CUSTOM_EVENT_TYPE     AnalyticsEventType = "custom"
+)
+
 type AnalyticsEventsService service
 
 type AnalyticsEvent struct {
-	Type string `json:"type"`
-	Data struct {
-		Q           string   `json:"q"`
-		Collections []string `json:"collections"`
-	} `json:"data"`
+	Type AnalyticsEventType `json:"type"`
+
+	// Name is the name of the event in the source of an analytics rule. It is
+	// required for all but search events.
+	Name string             `json:"name,omitempty"`
+	Data AnalyticsEventData `json:"data"`
+}
+
+type AnalyticsEventData struct {
+	Q           string   `json:"q,omitempty"`
+	Collections []string `json:"collections,omitempty"`
+	DocId       string   `json:"doc_id,omitempty"`
+	UserId      string   `json:"user_id,omitempty"`
+
+	// Position is the 1-based position of the clicked document in the
+	// results of the search Q.
+	Position int `json:"position,omitempty"`
+}
+
+// SearchEvent returns an event recording the search query q on collections.
+func SearchEvent(q string, collections ...string) *AnalyticsEvent {
+	return &AnalyticsEvent{Type: SEARCH_EVENT_TYPE, Data: AnalyticsEventData{Q: q, Collections: collections}}
+}
+
+// ClickEvent returns the event name recording that userId clicked the
+// document docId at position in the results of the search q.
+func ClickEvent(name, q, docId, userId string, position int) *AnalyticsEvent {
+	return &AnalyticsEvent{Type: CLICK_EVENT_TYPE, Name: name, Data: AnalyticsEventData{
+		Q: q, DocId: docId, UserId: userId, Position: position,
+	}}
+}
+
+// ConversionEvent returns the event name recording that userId converted on
+// the document docId, e.g. bought a product.
+func ConversionEvent(name, docId, userId string) *AnalyticsEvent {
+	return &AnalyticsEvent{Type: CONVERSION_EVENT_TYPE, Name: name, Data: AnalyticsEventData{DocId: docId, UserId: userId}}
+}
+
+// VisitEvent returns the event name recording that userId visited the
+// document docId.
+func VisitEvent(name, docId, userId string) *AnalyticsEvent {
+	return &AnalyticsEvent{Type: VISIT_EVENT_TYPE, Name: name, Data: AnalyticsEventData{DocId: docId, UserId: userId}}
+}
+
+// CustomEvent returns the application-defined event name about the document
+// docId and userId.
+func CustomEvent(name, docId, userId string) *AnalyticsEvent {
+	return &AnalyticsEvent{Type: CUSTOM_EVENT_TYPE, Name: name, Data: AnalyticsEventData{DocId: docId, UserId: userId}}
 }
 
 type AnalyticsEventCreateResponse struct {
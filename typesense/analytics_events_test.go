@@ -25,10 +25,7 @@ func TestAnalyticsEventsService_Create(t *testing.T) {
 	ctx := context.Background()
 	body := &AnalyticsEvent{
 		Type: "search",
-		Data: struct {
-			Q           string   `json:"q"`
-			Collections []string `json:"collections"`
-		}{
+		Data: AnalyticsEventData{
 			Q:           "Nike shoes",
 			Collections: []string{"products"},
 		},
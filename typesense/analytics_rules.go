@@ -9,6 +9,8 @@ type AnalyticsRuleType string
 
 const (
 	POPULAR_QUERIES_TYPE AnalyticsRuleType = "popular_queries"
+	NOHITS_QUERIES_TYPE  AnalyticsRuleType = "nohits_queries"
+	COUNTER_TYPE         AnalyticsRuleType = "counter"
 )
 
 type AnalyticsRulesService service
@@ -23,31 +25,137 @@ type AnalyticsRuleListResponse struct {
 
 type AnalyticsRule struct {
 	Name   string              `json:"name"`
-	Type   AnalyticsRuleType   `json:"type"` // only "popular_queries" is supported
+	Type   AnalyticsRuleType   `json:"type"`
 	Params AnalyticsRuleParams `json:"params"`
 }
 
+// AnalyticsRuleParams holds the parameters of every rule type. Build them
+// with PopularQueriesParams, NohitsQueriesParams or CounterParams to set only
+// the ones a rule type accepts.
 type AnalyticsRuleParams struct {
-	Source struct {
-		Collections []string `json:"collections"`
-	} `json:"source"`
-	Destination struct {
-		Collection string `json:"collection"`
-	} `json:"destination"`
-	Limit int `json:"limit"`
+	Source      AnalyticsRuleSource      `json:"source"`
+	Destination AnalyticsRuleDestination `json:"destination"`
+
+	// Limit is the number of queries kept by popular_queries and
+	// nohits_queries rules.
+	Limit int `json:"limit,omitempty"`
+
+	// ExpandQuery aggregates the full query instead of the prefix typed so
+	// far when search-as-you-type queries are recorded.
+	ExpandQuery *bool `json:"expand_query,omitempty"`
+}
+
+type AnalyticsRuleSource struct {
+	Collections []string              `json:"collections"`
+	Events      []*AnalyticsRuleEvent `json:"events,omitempty"`
+}
+
+type AnalyticsRuleDestination struct {
+	Collection string `json:"collection"`
+
+	// CounterField is the field of the destination collection a counter rule
+	// increments.
+	CounterField *string `json:"counter_field,omitempty"`
+}
+
+// AnalyticsRuleEvent is an event sent to AnalyticsEventsService that a rule
+// aggregates. Name identifies the event when it is sent.
+type AnalyticsRuleEvent struct {
+	Type   AnalyticsEventType `json:"type"`
+	Name   string             `json:"name"`
+	Weight float64            `json:"weight,omitempty"`
 }
 
 type AnalyticsRuleUpsertSchema struct {
-	Type   AnalyticsRuleType `json:"type"` // only "popular_queries" is supported
-	Params struct {
-		Source struct {
-			Collections []string `json:"collections"`
-		} `json:"source"`
-		Destination struct {
-			Collection string `json:"collection"`
-		} `json:"destination"`
-		Limit int `json:"limit"`
-	} `json:"params"`
+	Type   AnalyticsRuleType   `json:"type"`
+	Params AnalyticsRuleParams `json:"params"`
+}
+
+// RuleParams are the typed parameters of one analytics rule type.
+type RuleParams interface {
+	RuleType() AnalyticsRuleType
+	RuleParams() AnalyticsRuleParams
+}
+
+// PopularQueriesParams aggregates the most frequent search queries of the
+// source collections into the destination collection.
+type PopularQueriesParams struct {
+	SourceCollections     []string
+	DestinationCollection string
+	Limit                 int
+	ExpandQuery           bool
+
+	// Events are optional search events to aggregate instead of the queries
+	// the server records itself.
+	Events []*AnalyticsRuleEvent
+}
+
+func (p *PopularQueriesParams) RuleType() AnalyticsRuleType { return POPULAR_QUERIES_TYPE }
+
+func (p *PopularQueriesParams) RuleParams() AnalyticsRuleParams {
+	return queryRuleParams(p.SourceCollections, p.Events, p.DestinationCollection, p.Limit, p.ExpandQuery)
+}
+
+// NohitsQueriesParams aggregates the search queries of the source collections
+// that found no results into the destination collection.
+type NohitsQueriesParams struct {
+	SourceCollections     []string
+	DestinationCollection string
+	Limit                 int
+	ExpandQuery           bool
+	Events                []*AnalyticsRuleEvent
+}
+
+func (p *NohitsQueriesParams) RuleType() AnalyticsRuleType { return NOHITS_QUERIES_TYPE }
+
+func (p *NohitsQueriesParams) RuleParams() AnalyticsRuleParams {
+	return queryRuleParams(p.SourceCollections, p.Events, p.DestinationCollection, p.Limit, p.ExpandQuery)
+}
+
+// CounterParams increments CounterField of the documents in the destination
+// collection by the weight of each event referring to them, e.g. to rank
+// products by popularity.
+type CounterParams struct {
+	SourceCollections     []string
+	Events                []*AnalyticsRuleEvent
+	DestinationCollection string
+	CounterField          string
+}
+
+func (p *CounterParams) RuleType() AnalyticsRuleType { return COUNTER_TYPE }
+
+func (p *CounterParams) RuleParams() AnalyticsRuleParams {
+	return AnalyticsRuleParams{
+		Source: AnalyticsRuleSource{Collections: p.SourceCollections, Events: p.Events},
+		Destination: AnalyticsRuleDestination{
+			Collection:   p.DestinationCollection,
+			CounterField: String(p.CounterField),
+		},
+	}
+}
+
+func queryRuleParams(collections []string, events []*AnalyticsRuleEvent, destination string, limit int, expand bool) AnalyticsRuleParams {
+	params := AnalyticsRuleParams{
+		Source:      AnalyticsRuleSource{Collections: collections, Events: events},
+		Destination: AnalyticsRuleDestination{Collection: destination},
+		Limit:       limit,
+	}
+	if expand {
+		params.ExpandQuery = Bool(true)
+	}
+	return params
+}
+
+// NewAnalyticsRule returns the rule named name with the given parameters, to
+// be passed to AnalyticsRulesService.Create.
+func NewAnalyticsRule(name string, params RuleParams) *AnalyticsRule {
+	return &AnalyticsRule{Name: name, Type: params.RuleType(), Params: params.RuleParams()}
+}
+
+// NewAnalyticsRuleUpsertSchema returns a rule with the given parameters, to
+// be passed to AnalyticsRulesService.Upsert.
+func NewAnalyticsRuleUpsertSchema(params RuleParams) *AnalyticsRuleUpsertSchema {
+	return &AnalyticsRuleUpsertSchema{Type: params.RuleType(), Params: params.RuleParams()}
 }
 
 func (s *AnalyticsRulesService) List(ctx context.Context) (*AnalyticsRuleListResponse, error) {
@@ -2,6 +2,7 @@ package typesense
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -45,14 +46,10 @@ func TestAnalyticsRulesService_List(t *testing.T) {
 		Rules: []*AnalyticsRule{{
 			Name: "product_queries_aggregation",
 			Params: AnalyticsRuleParams{
-				Source: struct {
-					Collections []string `json:"collections"`
-				}{
+				Source: AnalyticsRuleSource{
 					Collections: []string{"products"},
 				},
-				Destination: struct {
-					Collection string `json:"collection"`
-				}{
+				Destination: AnalyticsRuleDestination{
 					Collection: "product_queries",
 				},
 				Limit: 1000,
@@ -91,14 +88,10 @@ func TestAnalyticsRulesService_Create(t *testing.T) {
 	body := &AnalyticsRule{
 		Name: "product_queries_aggregation",
 		Params: AnalyticsRuleParams{
-			Source: struct {
-				Collections []string `json:"collections"`
-			}{
+			Source: AnalyticsRuleSource{
 				Collections: []string{"products"},
 			},
-			Destination: struct {
-				Collection string `json:"collection"`
-			}{
+			Destination: AnalyticsRuleDestination{
 				Collection: "product_queries",
 			},
 			Limit: 1000,
@@ -144,14 +137,10 @@ func TestAnalyticsRulesService_Get(t *testing.T) {
 	want := &AnalyticsRule{
 		Name: "product_queries_aggregation",
 		Params: AnalyticsRuleParams{
-			Source: struct {
-				Collections []string `json:"collections"`
-			}{
+			Source: AnalyticsRuleSource{
 				Collections: []string{"products"},
 			},
-			Destination: struct {
-				Collection string `json:"collection"`
-			}{
+			Destination: AnalyticsRuleDestination{
 				Collection: "product_queries",
 			},
 			Limit: 1000,
@@ -191,14 +180,10 @@ func TestAnalyticsRulesService_Upsert(t *testing.T) {
 	ctx := context.Background()
 	body := &AnalyticsRuleUpsertSchema{
 		Params: AnalyticsRuleParams{
-			Source: struct {
-				Collections []string `json:"collections"`
-			}{
+			Source: AnalyticsRuleSource{
 				Collections: []string{"products"},
 			},
-			Destination: struct {
-				Collection string `json:"collection"`
-			}{
+			Destination: AnalyticsRuleDestination{
 				Collection: "product_queries",
 			},
 			Limit: 1000,
@@ -210,14 +195,10 @@ func TestAnalyticsRulesService_Upsert(t *testing.T) {
 	want := &AnalyticsRule{
 		Name: "product_queries_aggregation",
 		Params: AnalyticsRuleParams{
-			Source: struct {
-				Collections []string `json:"collections"`
-			}{
+			Source: AnalyticsRuleSource{
 				Collections: []string{"products"},
 			},
-			Destination: struct {
-				Collection string `json:"collection"`
-			}{
+			Destination: AnalyticsRuleDestination{
 				Collection: "product_queries",
 			},
 			Limit: 1000,
@@ -252,3 +233,101 @@ func TestAnalyticsRulesService_Delete(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func TestAnalyticsRulesService_TypedParams(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	mux.HandleFunc("/analytics/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body = nil
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/analytics/rules/product_clicks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body = nil
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		fmt.Fprint(w, `{}`)
+	})
+
+	tests := []struct {
+		name   string
+		rule   string
+		params RuleParams
+		want   string
+	}{
+		{
+			name: "nohits_queries",
+			rule: "product_no_hits",
+			params: &NohitsQueriesParams{
+				SourceCollections:     []string{"products"},
+				DestinationCollection: "no_hits_queries",
+				Limit:                 1000,
+				ExpandQuery:           true,
+			},
+			want: `{
+				"name": "product_no_hits",
+				"type": "nohits_queries",
+				"params": {
+					"source": {"collections": ["products"]},
+					"destination": {"collection": "no_hits_queries"},
+					"limit": 1000,
+					"expand_query": true
+				}
+			}`,
+		},
+		{
+			name: "counter",
+			rule: "product_popularity",
+			params: &CounterParams{
+				SourceCollections: []string{"products"},
+				Events: []*AnalyticsRuleEvent{
+					{Type: CLICK_EVENT_TYPE, Weight: 1, Name: "products_click_event"},
+					{Type: CONVERSION_EVENT_TYPE, Weight: 5, Name: "products_purchase_event"},
+				},
+				DestinationCollection: "products",
+				CounterField:          "popularity",
+			},
+			want: `{
+				"name": "product_popularity",
+				"type": "counter",
+				"params": {
+					"source": {
+						"collections": ["products"],
+						"events": [
+							{"type": "click", "weight": 1, "name": "products_click_event"},
+							{"type": "conversion", "weight": 5, "name": "products_purchase_event"}
+						]
+					},
+					"destination": {"collection": "products", "counter_field": "popularity"}
+				}
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.AnalyticsRules.Create(context.Background(), NewAnalyticsRule(tt.rule, tt.params))
+			assert.NoError(t, err)
+			got, _ := json.Marshal(body)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+
+	_, err := client.AnalyticsRules.Upsert(context.Background(), "product_clicks", NewAnalyticsRuleUpsertSchema(&PopularQueriesParams{
+		SourceCollections:     []string{"products"},
+		DestinationCollection: "product_queries",
+		Limit:                 100,
+	}))
+	assert.NoError(t, err)
+	got, _ := json.Marshal(body)
+	assert.JSONEq(t, `{
+		"type": "popular_queries",
+		"params": {
+			"source": {"collections": ["products"]},
+			"destination": {"collection": "product_queries"},
+			"limit": 100
+		}
+	}`, string(got))
+}
@@ -0,0 +1,404 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrNoLeader is returned by ClusterTransport.Leader when no node reports
+// itself as the raft leader.
+var ErrNoLeader = errors.New("typesense: no leader found")
+
+// ErrNotCaughtUp is returned for reads with ReadAfterWrite when the leader is
+// not known and no follower has committed the last write.
+var ErrNotCaughtUp = errors.New("typesense: no node has caught up with the last write")
+
+// ClusterTransport is an http.RoundTripper for a client of a multi-node
+// cluster. It sends writes straight to the raft leader, saving the hop of a
+// follower forwarding them, and spreads reads across the followers. The
+// leader is discovered through /debug and rediscovered when a request fails
+// with a network error, a 503 or a redirect. While no leader is known, e.g.
+// during an election, writes are sent to the nodes in turn, as followers
+// forward them to the leader.
+//
+// Requests are considered reads if they are GET or HEAD requests or
+// multi-searches, and writes otherwise.
+type ClusterTransport struct {
+	// Nodes are the server URLs of the nodes. Requests are rewritten to the
+	// scheme and host of the selected node.
+	Nodes []string
+
+	// APIKey authenticates the requests of the transport itself, to /debug
+	// for discovery and to /status for ReadAfterWrite. It needs permission
+	// for both; the key of the requests sent through the transport is not
+	// used for them, as scoped keys rarely have it.
+	APIKey string
+
+	// Credentials, if set, provides the key for the requests of the
+	// transport instead of APIKey. NewClusterClient sets it to follow the
+	// credentials of the client, including keys set with Client.SetAPIKey.
+	Credentials CredentialProvider
+
+	// Base sends the requests. Default: http.DefaultTransport
+	Base http.RoundTripper
+
+	// Watcher, if set, provides the leader and the healthy nodes instead of
+	// discovery by the transport.
+	Watcher *Watcher
+
+	// ReadAfterWrite makes reads observe all writes sent through the
+	// transport before. After each write, the committed index of the leader
+	// is captured and reads are only sent to followers that report having
+	// committed it, or to the leader otherwise. This costs a /status request
+	// after each write and for each follower that was behind. Reads are
+	// never sent to a follower that is behind: if the leader is not known
+	// and cannot be discovered, they fail with ErrNotCaughtUp instead.
+	ReadAfterWrite bool
+
+	mu     sync.Mutex
+	leader string
+	next   atomic.Uint32
+
+	// minIndex is the committed index captured after the last write, indexes
+	// the last committed index reported by each node. pinned routes reads to
+	// the leader if the index could not be captured.
+	minIndex int
+	indexes  map[string]int
+	pinned   bool
+}
+
+// NewClusterClient returns a Client sending its requests through a
+// ClusterTransport for the nodes. httpClient's transport, if any, is used as
+// the base transport.
+func NewClusterClient(httpClient *http.Client, apiKey string, nodes ...string) (*Client, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("at least one node is required")
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	hc := *httpClient
+	transport := &ClusterTransport{Nodes: nodes, APIKey: apiKey, Base: httpClient.Transport}
+	hc.Transport = transport
+	c, err := NewClient(&hc, nodes[0], apiKey)
+	if err != nil {
+		return nil, err
+	}
+	transport.Credentials = CredentialFunc(func() (string, error) {
+		return c.Credentials().APIKey()
+	})
+	return c, nil
+}
+
+func (t *ClusterTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ClusterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isRead(req) {
+		return t.read(req)
+	}
+	return t.write(req)
+}
+
+func isRead(req *http.Request) bool {
+	return req.Method == "GET" || req.Method == "HEAD" || strings.HasSuffix(req.URL.Path, "/multi_search")
+}
+
+// read sends req to the followers in turn, starting with the next one in
+// round-robin order, and to the leader last, until a node responds without a
+// 503. With ReadAfterWrite, followers behind the last write are skipped.
+func (t *ClusterTransport) read(req *http.Request) (*http.Response, error) {
+	if t.ReadAfterWrite {
+		// the leader is the only node known to have every write
+		t.Leader(req.Context())
+	}
+	followers, leader := t.readNodes()
+	nodes := t.rotate(followers)
+	if leader != "" {
+		nodes = append(nodes, leader)
+	}
+
+	var resp *http.Response
+	var err error
+	sent := false
+	for _, node := range nodes {
+		if sent && !rewindable(req) {
+			break
+		}
+		if t.ReadAfterWrite && node != leader && !t.caughtUp(req.Context(), node) {
+			continue
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		resp, err = t.send(req, node)
+		sent = true
+		if !failover(resp, err) {
+			return resp, err
+		}
+	}
+	if !sent && t.ReadAfterWrite {
+		return nil, ErrNotCaughtUp
+	}
+	return resp, err
+}
+
+// write sends req to the leader and, if that fails in a way that suggests
+// the leader changed, once more to the rediscovered leader. If no leader is
+// known, req is sent to any node and the leader is discovered again by the
+// next write.
+func (t *ClusterTransport) write(req *http.Request) (*http.Response, error) {
+	leader, err := t.Leader(req.Context())
+	if err != nil {
+		resp, err := t.sendAny(req)
+		if t.ReadAfterWrite && err == nil && resp.StatusCode < 300 {
+			t.capture(req.Context(), "")
+		}
+		return resp, err
+	}
+	resp, err := t.send(req, leader)
+	if failover(resp, err) && rewindable(req) {
+		t.forget(leader)
+		if next, lerr := t.Leader(req.Context()); lerr == nil && next != leader {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			leader = next
+			resp, err = t.send(req, leader)
+		}
+	}
+	if t.ReadAfterWrite && err == nil && resp.StatusCode < 300 {
+		t.capture(req.Context(), leader)
+	}
+	return resp, err
+}
+
+// sendAny sends req to the nodes in turn, starting with the next one in
+// round-robin order, until a node responds without a 503.
+func (t *ClusterTransport) sendAny(req *http.Request) (*http.Response, error) {
+	followers, _ := t.readNodes()
+	var resp *http.Response
+	err := ErrNoLeader
+	for i, node := range t.rotate(followers) {
+		if i > 0 && !rewindable(req) {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		resp, err = t.send(req, node)
+		if !failover(resp, err) {
+			break
+		}
+	}
+	return resp, err
+}
+
+// rotate returns nodes starting with the next one in round-robin order.
+func (t *ClusterTransport) rotate(nodes []string) []string {
+	n := len(nodes)
+	if n == 0 {
+		return nil
+	}
+	start := int(t.next.Add(1) % uint32(n))
+	return append(nodes[start:n:n], nodes[:start]...)
+}
+
+// failover reports whether a request should be sent to another node.
+func failover(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp.StatusCode == http.StatusServiceUnavailable || resp.StatusCode >= 300 && resp.StatusCode < 400
+}
+
+func rewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func (t *ClusterTransport) send(req *http.Request, node string) (*http.Response, error) {
+	u, err := url.Parse(node)
+	if err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	r.URL.Scheme = u.Scheme
+	r.URL.Host = u.Host
+	r.Host = ""
+	if req.GetBody != nil {
+		if r.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return t.base().RoundTrip(r)
+}
+
+// readNodes returns the healthy nodes other than the leader, and the leader
+// if it is known and healthy.
+func (t *ClusterTransport) readNodes() (followers []string, leader string) {
+	nodes := t.Nodes
+	if t.Watcher != nil {
+		if healthy := t.Watcher.Healthy(); len(healthy) > 0 {
+			nodes = healthy
+		}
+		leader = t.Watcher.Leader()
+	} else {
+		t.mu.Lock()
+		leader = t.leader
+		t.mu.Unlock()
+	}
+
+	found := false
+	for _, n := range nodes {
+		if n == leader {
+			found = true
+		} else {
+			followers = append(followers, n)
+		}
+	}
+	if !found {
+		leader = ""
+	}
+	return followers, leader
+}
+
+// Leader returns the URL of the current leader, discovering it if it is not
+// known.
+func (t *ClusterTransport) Leader(ctx context.Context) (string, error) {
+	if t.Watcher != nil {
+		if leader := t.Watcher.Leader(); leader != "" {
+			return leader, nil
+		}
+		return "", ErrNoLeader
+	}
+
+	t.mu.Lock()
+	leader := t.leader
+	t.mu.Unlock()
+	if leader != "" {
+		return leader, nil
+	}
+
+	// the nodes are probed without holding mu, so that reads and other
+	// requests are not blocked behind a slow or unreachable node
+	for _, node := range t.Nodes {
+		state, err := t.raftState(ctx, node)
+		if err == nil && state == RaftLeader {
+			t.mu.Lock()
+			t.leader = node
+			t.mu.Unlock()
+			return node, nil
+		}
+	}
+	return "", ErrNoLeader
+}
+
+// Refresh forgets the current leader and discovers it again.
+func (t *ClusterTransport) Refresh(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	t.leader = ""
+	t.mu.Unlock()
+	return t.Leader(ctx)
+}
+
+// forget drops leader so the next write rediscovers the leader.
+func (t *ClusterTransport) forget(leader string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.leader == leader {
+		t.leader = ""
+	}
+}
+
+// capture records the committed index of the leader after a write. Without
+// a leader, reads are pinned to the leader until the next write.
+func (t *ClusterTransport) capture(ctx context.Context, leader string) {
+	index, err := 0, ErrNoLeader
+	if leader != "" {
+		index, err = t.committedIndex(ctx, leader)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pinned = err != nil
+	if err == nil && index > t.minIndex {
+		t.minIndex = index
+	}
+}
+
+// caughtUp reports whether node has committed the index captured after the
+// last write, asking the node only if its last reported index is behind.
+func (t *ClusterTransport) caughtUp(ctx context.Context, node string) bool {
+	t.mu.Lock()
+	pinned, minIndex, index := t.pinned, t.minIndex, t.indexes[node]
+	t.mu.Unlock()
+	if pinned {
+		return false
+	}
+	if index >= minIndex {
+		return true
+	}
+	index, err := t.committedIndex(ctx, node)
+	return err == nil && index >= minIndex
+}
+
+// committedIndex returns the committed index reported by node's /status.
+func (t *ClusterTransport) committedIndex(ctx context.Context, node string) (int, error) {
+	var status NodeStatus
+	if err := t.get(ctx, node, "/status", &status); err != nil {
+		return 0, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.indexes == nil {
+		t.indexes = make(map[string]int)
+	}
+	if status.CommittedIndex > t.indexes[node] {
+		t.indexes[node] = status.CommittedIndex
+	}
+	return status.CommittedIndex, nil
+}
+
+func (t *ClusterTransport) raftState(ctx context.Context, node string) (RaftState, error) {
+	var debug Debug
+	if err := t.get(ctx, node, "/debug", &debug); err != nil {
+		return RaftUnknown, err
+	}
+	return debug.State, nil
+}
+
+// get decodes the response to a GET request of path on node into v.
+func (t *ClusterTransport) get(ctx context.Context, node, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(node, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+	apiKey := t.APIKey
+	if t.Credentials != nil {
+		if apiKey, err = t.Credentials.APIKey(); err != nil {
+			return fmt.Errorf("api key: %w", err)
+		}
+	}
+	req.Header.Set(headerAPIKEy, apiKey)
+
+	resp, err := t.base().RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("typesense: %s%s: %s", node, path, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,394 @@
+package typesense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNode is a server reporting a raft state on /debug and a committed
+// index on /status, and recording the other requests it served. Writes
+// served by the leader increment the committed index. Requests without the
+// node's API key are rejected, except for those with the scoped key to paths
+// other than /debug and /status.
+type fakeNode struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	apiKey      string
+	scopedKey   string
+	state       RaftState
+	index       int
+	unavailable bool
+	requests    []string
+}
+
+func newFakeNode(t *testing.T, state RaftState) *fakeNode {
+	n := &fakeNode{apiKey: "xyz", state: state}
+	n.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		key := r.Header.Get(headerAPIKEy)
+		meta := r.URL.Path == "/debug" || r.URL.Path == "/status"
+		if key != n.apiKey && (meta || n.scopedKey == "" || key != n.scopedKey) {
+			http.Error(w, `{"message":"Forbidden - a valid x-typesense-api-key header must be sent."}`, http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/debug" {
+			fmt.Fprintf(w, `{"state":%d,"version":"0.25.0"}`, n.state)
+			return
+		}
+		if r.URL.Path == "/status" {
+			fmt.Fprintf(w, `{"state":"%s","committed_index":%d,"queued_writes":0}`, n.state, n.index)
+			return
+		}
+		n.requests = append(n.requests, r.Method+" "+r.URL.Path)
+		if n.unavailable {
+			http.Error(w, `{"message":"Not Ready or Lagging"}`, http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method != "GET" && n.state == RaftLeader {
+			n.index++
+		}
+		w.Write([]byte(`{"id":"1","name":"companies"}`))
+	}))
+	t.Cleanup(n.Close)
+	return n
+}
+
+func (n *fakeNode) set(state RaftState, unavailable bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.state, n.unavailable = state, unavailable
+}
+
+func (n *fakeNode) setAPIKey(apiKey string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.apiKey = apiKey
+}
+
+func (n *fakeNode) setScopedKey(scopedKey string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.scopedKey = scopedKey
+}
+
+func (n *fakeNode) setIndex(index int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.index = index
+}
+
+func (n *fakeNode) served() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.requests
+}
+
+func TestClusterClient_Routing(t *testing.T) {
+	leader := newFakeNode(t, RaftLeader)
+	follower1 := newFakeNode(t, RaftFollower)
+	follower2 := newFakeNode(t, RaftFollower)
+
+	client, err := NewClusterClient(nil, "xyz", follower1.URL, leader.URL, follower2.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "1"})
+	require.NoError(t, err)
+	_, err = client.Collections.Create(ctx, &CollectionSchema{Name: "companies", Fields: []*Field{{Name: "name", Type: "string"}}})
+	require.NoError(t, err)
+	_, err = client.Documents.MultiSearch(ctx, &MultiSearchSearchesParameter{}, nil)
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		_, err = client.Collections.Get(ctx, "companies")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"POST /collections/companies/documents", "POST /collections"}, leader.served())
+	assert.Len(t, append(follower1.served(), follower2.served()...), 5)
+	assert.NotEmpty(t, follower1.served())
+	assert.NotEmpty(t, follower2.served())
+}
+
+func TestClusterClient_LeaderChange(t *testing.T) {
+	node1 := newFakeNode(t, RaftLeader)
+	node2 := newFakeNode(t, RaftFollower)
+
+	client, err := NewClusterClient(nil, "xyz", node1.URL, node2.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "1"})
+	require.NoError(t, err)
+
+	node1.set(RaftFollower, true)
+	node2.set(RaftLeader, false)
+
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "2"})
+	require.NoError(t, err)
+	assert.Len(t, node1.served(), 2)
+	assert.Equal(t, []string{"POST /collections/companies/documents"}, node2.served())
+
+	transport := client.client.Transport.(*ClusterTransport)
+	leader, err := transport.Leader(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, node2.URL, leader)
+}
+
+func TestClusterClient_RotatedKey(t *testing.T) {
+	leader := newFakeNode(t, RaftLeader)
+	follower := newFakeNode(t, RaftFollower)
+
+	client, err := NewClusterClient(nil, "xyz", follower.URL, leader.URL)
+	require.NoError(t, err)
+	client.client.Transport.(*ClusterTransport).ReadAfterWrite = true
+	ctx := context.Background()
+
+	leader.setAPIKey("abc")
+	follower.setAPIKey("abc")
+	client.SetAPIKey("abc")
+
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "1"})
+	require.NoError(t, err)
+	index, err := client.CommittedIndex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, index)
+
+	follower.setIndex(1)
+	assert.NoError(t, client.WaitForCommit(ctx, index))
+	_, err = client.Collections.Get(ctx, "companies")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"POST /collections/companies/documents"}, leader.served())
+	assert.Equal(t, []string{"GET /collections/companies"}, follower.served())
+}
+
+func TestClusterTransport_ScopedKey(t *testing.T) {
+	leader := newFakeNode(t, RaftLeader)
+	follower := newFakeNode(t, RaftFollower)
+	leader.setScopedKey("scoped")
+	follower.setScopedKey("scoped")
+
+	transport := &ClusterTransport{Nodes: []string{follower.URL, leader.URL}, APIKey: "xyz", ReadAfterWrite: true}
+	client, err := NewClient(&http.Client{Transport: transport}, leader.URL, "scoped")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "1"})
+	require.NoError(t, err)
+	follower.setIndex(1)
+	_, err = client.Collections.Get(ctx, "companies")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"POST /collections/companies/documents"}, leader.served())
+	assert.Equal(t, []string{"GET /collections/companies"}, follower.served())
+}
+
+func TestClusterTransport_NoLeader(t *testing.T) {
+	node1 := newFakeNode(t, RaftCandidate)
+	node2 := newFakeNode(t, RaftCandidate)
+	node1.set(RaftCandidate, true)
+
+	client, err := NewClusterClient(nil, "xyz", node1.URL, node2.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// the write is sent to the nodes in turn until one accepts it
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"POST /collections/companies/documents"}, node2.served())
+
+	transport := client.client.Transport.(*ClusterTransport)
+	_, err = transport.Leader(ctx)
+	assert.ErrorIs(t, err, ErrNoLeader)
+
+	// the leader is discovered again by the next write
+	node1.set(RaftLeader, false)
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"POST /collections/companies/documents"}, node1.served()[len(node1.served())-1:])
+	assert.Len(t, node2.served(), 1)
+
+	_, err = client.Collections.Get(ctx, "companies")
+	assert.NoError(t, err)
+}
+
+func TestClusterTransport_NoLeaderWithWatcher(t *testing.T) {
+	node := newFakeNode(t, RaftCandidate)
+
+	transport := &ClusterTransport{Nodes: []string{node.URL}, APIKey: "xyz", Watcher: NewWatcher()}
+	client, err := NewClient(&http.Client{Transport: transport}, node.URL, "xyz")
+	require.NoError(t, err)
+
+	_, err = client.Documents.Create(context.Background(), "companies", map[string]interface{}{"id": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"POST /collections/companies/documents"}, node.served())
+}
+
+func TestClusterTransport_LeaderProbeUnlocked(t *testing.T) {
+	probing := make(chan struct{})
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(probing)
+		<-release
+		fmt.Fprint(w, `{"state":4}`)
+	}))
+	defer slow.Close()
+	defer close(release)
+	leader := newFakeNode(t, RaftLeader)
+
+	transport := &ClusterTransport{Nodes: []string{slow.URL, leader.URL}, APIKey: "xyz"}
+	go transport.Leader(context.Background())
+	<-probing
+
+	// reads must not wait for the probe of the slow node
+	done := make(chan struct{})
+	go func() {
+		transport.readNodes()
+		transport.forget(leader.URL)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Leader holds the lock while probing the nodes")
+	}
+}
+
+func TestClusterTransport_ReadFailover(t *testing.T) {
+	leader := newFakeNode(t, RaftLeader)
+	follower := newFakeNode(t, RaftFollower)
+	follower.set(RaftFollower, true)
+
+	transport := &ClusterTransport{Nodes: []string{leader.URL, follower.URL}, APIKey: "xyz"}
+	_, err := transport.Refresh(context.Background())
+	require.NoError(t, err)
+
+	client, err := NewClient(&http.Client{Transport: transport}, leader.URL, "xyz")
+	require.NoError(t, err)
+
+	_, err = client.Collections.Get(context.Background(), "companies")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /collections/companies"}, follower.served())
+	assert.Equal(t, []string{"GET /collections/companies"}, leader.served())
+}
+
+func TestClusterTransport_ReadAfterWrite(t *testing.T) {
+	leader := newFakeNode(t, RaftLeader)
+	follower := newFakeNode(t, RaftFollower)
+	leader.setIndex(10)
+	follower.setIndex(10)
+
+	transport := &ClusterTransport{Nodes: []string{leader.URL, follower.URL}, APIKey: "xyz", ReadAfterWrite: true}
+	client, err := NewClient(&http.Client{Transport: transport}, leader.URL, "xyz")
+	require.NoError(t, err)
+	ctx := context.Background()
+	_, err = transport.Refresh(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Collections.Get(ctx, "companies")
+	require.NoError(t, err)
+	assert.Len(t, follower.served(), 1)
+
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "1"})
+	require.NoError(t, err)
+
+	// the follower has not applied the write yet
+	_, err = client.Collections.Get(ctx, "companies")
+	require.NoError(t, err)
+	assert.Len(t, follower.served(), 1)
+	assert.Equal(t, []string{"POST /collections/companies/documents", "GET /collections/companies"}, leader.served())
+
+	follower.setIndex(11)
+	_, err = client.Collections.Get(ctx, "companies")
+	require.NoError(t, err)
+	assert.Len(t, follower.served(), 2)
+	assert.Len(t, leader.served(), 2)
+}
+
+func TestClusterTransport_ReadAfterWriteNoLeader(t *testing.T) {
+	leader := newFakeNode(t, RaftLeader)
+	follower := newFakeNode(t, RaftFollower)
+	leader.setIndex(10)
+	follower.setIndex(10)
+
+	transport := &ClusterTransport{Nodes: []string{leader.URL, follower.URL}, APIKey: "xyz", ReadAfterWrite: true}
+	client, err := NewClient(&http.Client{Transport: transport}, leader.URL, "xyz")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"id": "1"})
+	require.NoError(t, err)
+
+	// the leader goes away before the follower has applied the write
+	leader.Close()
+	_, err = transport.Refresh(ctx)
+	require.ErrorIs(t, err, ErrNoLeader)
+
+	_, err = client.Collections.Get(ctx, "companies")
+	assert.Error(t, err)
+	assert.Empty(t, follower.served())
+
+	transport.Nodes = []string{follower.URL}
+	_, err = client.Collections.Get(ctx, "companies")
+	assert.ErrorIs(t, err, ErrNotCaughtUp)
+	assert.Empty(t, follower.served())
+
+	follower.setIndex(11)
+	_, err = client.Collections.Get(ctx, "companies")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /collections/companies"}, follower.served())
+}
+
+func TestClient_WaitForCommit(t *testing.T) {
+	leader := newFakeNode(t, RaftLeader)
+	follower := newFakeNode(t, RaftFollower)
+	leader.setIndex(5)
+	follower.setIndex(4)
+
+	client, err := NewClusterClient(nil, "xyz", leader.URL, follower.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	index, err := client.CommittedIndex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, index)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	err = client.WaitForCommit(timeoutCtx, index)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	time.AfterFunc(100*time.Millisecond, func() { follower.setIndex(5) })
+	timeoutCtx, cancel = context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	assert.NoError(t, client.WaitForCommit(timeoutCtx, index))
+}
+
+func TestClient_WaitForCommitSingleNode(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	index := 3
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		index++
+		fmt.Fprintf(w, `{"committed_index":%d,"queued_writes":0,"state":"LEADER"}`, index)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, client.WaitForCommit(ctx, 6))
+	assert.Equal(t, 6, index)
+}
@@ -21,7 +21,16 @@ func (s *CollectionsService) List(ctx context.Context) ([]*CollectionSchema, err
 	return res, nil
 }
 
+// Create creates a collection. The embedding configuration of auto-embedding
+// fields is validated before the request is sent; use CollectionSchema.Validate
+// to check the whole schema.
 func (s *CollectionsService) Create(ctx context.Context, body *CollectionSchema) (*Collection, error) {
+	if body != nil {
+		if err := validateEmbeds(body.Fields); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := s.client.NewRequest("POST", "/collections", body)
 	if err != nil {
 		return nil, err
@@ -53,6 +62,12 @@ func (s *CollectionsService) Get(ctx context.Context, collectionName string) (*C
 }
 
 func (s *CollectionsService) Update(ctx context.Context, collectionName string, body *CollectionUpdateSchema) (*CollectionUpdateSchema, error) {
+	if body != nil {
+		if err := validateEmbeds(body.Fields); err != nil {
+			return nil, err
+		}
+	}
+
 	u := fmt.Sprintf("/collections/%s", collectionName)
 	req, err := s.client.NewRequest("PATCH", u, body)
 	if err != nil {
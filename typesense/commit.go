@@ -0,0 +1,78 @@
+package typesense
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const commitPollInterval = 50 * time.Millisecond
+
+// CommittedIndex returns the committed raft index of the server or, for a
+// client using a ClusterTransport, of the leader. Captured after a write, it
+// can be passed to WaitForCommit to wait for the write to reach the other
+// nodes.
+func (c *Client) CommittedIndex(ctx context.Context) (int, error) {
+	if t, ok := c.client.Transport.(*ClusterTransport); ok {
+		leader, err := t.Leader(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return t.committedIndex(ctx, leader)
+	}
+	status, err := c.Meta.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.CommittedIndex, nil
+}
+
+// WaitForCommit blocks until the committed index of the server is at least
+// minIndex or ctx is done. For a client using a ClusterTransport, it waits
+// for every node that responds, so subsequent reads observe the writes up to
+// minIndex whichever node serves them. Use a context with a timeout to bound
+// the wait.
+func (c *Client) WaitForCommit(ctx context.Context, minIndex int) error {
+	ticker := time.NewTicker(commitPollInterval)
+	defer ticker.Stop()
+	for {
+		ok, err := c.committed(ctx, minIndex)
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("typesense: waiting for index %d: %w (last error: %v)", minIndex, ctx.Err(), err)
+			}
+			return fmt.Errorf("typesense: waiting for index %d: %w", minIndex, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) committed(ctx context.Context, minIndex int) (bool, error) {
+	t, ok := c.client.Transport.(*ClusterTransport)
+	if !ok {
+		status, err := c.Meta.Status(ctx)
+		if err != nil {
+			return false, err
+		}
+		return status.CommittedIndex >= minIndex, nil
+	}
+
+	var lastErr error
+	responded := false
+	for _, node := range t.Nodes {
+		index, err := t.committedIndex(ctx, node)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if index < minIndex {
+			return false, nil
+		}
+		responded = true
+	}
+	return responded, lastErr
+}
@@ -0,0 +1,219 @@
+package typesense
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConversationsService manages the conversation models used by
+// conversational search and the conversation histories stored by the server.
+type ConversationsService service
+
+// ConversationModel defines model for ConversationModel.
+type ConversationModel struct {
+	Id string `json:"id"`
+
+	// ModelName Name of the LLM, e.g. `openai/gpt-3.5-turbo` or
+	// `cloudflare/@cf/mistral/mistral-7b-instruct-v0.1`.
+	ModelName string `json:"model_name"`
+
+	// ApiKey The LLM service's API key. The server returns it redacted.
+	ApiKey *string `json:"api_key,omitempty"`
+
+	// AccountId Account ID for Cloudflare-specific models.
+	AccountId *string `json:"account_id,omitempty"`
+
+	// SystemPrompt The system prompt that contains special instructions to
+	// the LLM.
+	SystemPrompt *string `json:"system_prompt,omitempty"`
+
+	// MaxBytes The maximum number of bytes sent to the LLM in every API
+	// call.
+	MaxBytes int `json:"max_bytes"`
+
+	// HistoryCollection The collection the conversation histories are
+	// stored in.
+	HistoryCollection *string `json:"history_collection,omitempty"`
+
+	// Ttl Time interval in seconds after which the messages would be
+	// deleted.
+	Ttl *int `json:"ttl,omitempty"`
+
+	// VllmUrl URL of a vLLM service.
+	VllmUrl *string `json:"vllm_url,omitempty"`
+}
+
+// ConversationModelSchema defines model for ConversationModelSchema.
+type ConversationModelSchema struct {
+	// Id An explicit id for the model, otherwise the server generates one.
+	Id *string `json:"id,omitempty"`
+
+	ModelName         string  `json:"model_name"`
+	ApiKey            *string `json:"api_key,omitempty"`
+	AccountId         *string `json:"account_id,omitempty"`
+	SystemPrompt      *string `json:"system_prompt,omitempty"`
+	MaxBytes          int     `json:"max_bytes"`
+	HistoryCollection *string `json:"history_collection,omitempty"`
+	Ttl               *int    `json:"ttl,omitempty"`
+	VllmUrl           *string `json:"vllm_url,omitempty"`
+}
+
+// Conversation defines model for Conversation.
+type Conversation struct {
+	Id string `json:"id"`
+
+	// Conversation The messages exchanged in the conversation.
+	Conversation []map[string]interface{} `json:"conversation"`
+
+	// LastUpdated Unix timestamp of the last message.
+	LastUpdated int64 `json:"last_updated"`
+
+	// Ttl Time in seconds after which the conversation is deleted.
+	Ttl int `json:"ttl"`
+}
+
+// ConversationsResponse defines model for ConversationsResponse.
+type ConversationsResponse struct {
+	Conversations []*Conversation `json:"conversations"`
+}
+
+// ConversationUpdateSchema defines model for ConversationUpdateSchema.
+type ConversationUpdateSchema struct {
+	// Ttl Time in seconds after which the conversation is deleted.
+	Ttl int `json:"ttl"`
+}
+
+func (s *ConversationsService) ListModels(ctx context.Context) ([]*ConversationModel, error) {
+	u := "/conversations/models"
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*ConversationModel
+	err = s.client.Do(ctx, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ConversationsService) CreateModel(ctx context.Context, body *ConversationModelSchema) (*ConversationModel, error) {
+	u := "/conversations/models"
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ConversationModel{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ConversationsService) GetModel(ctx context.Context, modelId string) (*ConversationModel, error) {
+	u := fmt.Sprintf("/conversations/models/%s", modelId)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ConversationModel{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ConversationsService) UpdateModel(ctx context.Context, modelId string, body *ConversationModelSchema) (*ConversationModel, error) {
+	u := fmt.Sprintf("/conversations/models/%s", modelId)
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ConversationModel{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ConversationsService) DeleteModel(ctx context.Context, modelId string) (*ConversationModel, error) {
+	u := fmt.Sprintf("/conversations/models/%s", modelId)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ConversationModel{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ConversationsService) List(ctx context.Context) (*ConversationsResponse, error) {
+	u := "/conversations"
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ConversationsResponse{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ConversationsService) Get(ctx context.Context, conversationId string) (*Conversation, error) {
+	u := fmt.Sprintf("/conversations/%s", conversationId)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Conversation{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ConversationsService) Update(ctx context.Context, conversationId string, body *ConversationUpdateSchema) (*Conversation, error) {
+	u := fmt.Sprintf("/conversations/%s", conversationId)
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Conversation{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ConversationsService) Delete(ctx context.Context, conversationId string) (*Conversation, error) {
+	u := fmt.Sprintf("/conversations/%s", conversationId)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Conversation{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,185 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversationsService_CreateModel(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/conversations/models", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := &ConversationModelSchema{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		assert.Equal(t, "openai/gpt-3.5-turbo", body.ModelName)
+		fmt.Fprint(w, `{
+			"id": "conv-model-1",
+			"model_name": "openai/gpt-3.5-turbo",
+			"api_key": "sk-***",
+			"system_prompt": "You are an assistant for question-answering.",
+			"max_bytes": 16384,
+			"history_collection": "conversation_store"
+		}`)
+	})
+
+	got, err := client.Conversations.CreateModel(context.Background(), &ConversationModelSchema{
+		Id:                String("conv-model-1"),
+		ModelName:         "openai/gpt-3.5-turbo",
+		ApiKey:            String("sk-secret"),
+		SystemPrompt:      String("You are an assistant for question-answering."),
+		MaxBytes:          16384,
+		HistoryCollection: String("conversation_store"),
+	})
+
+	want := &ConversationModel{
+		Id:                "conv-model-1",
+		ModelName:         "openai/gpt-3.5-turbo",
+		ApiKey:            String("sk-***"),
+		SystemPrompt:      String("You are an assistant for question-answering."),
+		MaxBytes:          16384,
+		HistoryCollection: String("conversation_store"),
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestConversationsService_ListModels(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/conversations/models", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": "conv-model-1", "model_name": "openai/gpt-3.5-turbo", "max_bytes": 16384}]`)
+	})
+
+	got, err := client.Conversations.ListModels(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*ConversationModel{{Id: "conv-model-1", ModelName: "openai/gpt-3.5-turbo", MaxBytes: 16384}}, got)
+}
+
+func TestConversationsService_DeleteModel(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/conversations/models/conv-model-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		fmt.Fprint(w, `{"id": "conv-model-1", "model_name": "openai/gpt-3.5-turbo", "max_bytes": 16384}`)
+	})
+
+	got, err := client.Conversations.DeleteModel(context.Background(), "conv-model-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "conv-model-1", got.Id)
+}
+
+func TestConversationsService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/conversations/123", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body := &ConversationUpdateSchema{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		assert.Equal(t, 3600, body.Ttl)
+		fmt.Fprint(w, `{
+			"id": "123",
+			"conversation": [{"user": "can you suggest an action series"}],
+			"last_updated": 1694962465,
+			"ttl": 3600
+		}`)
+	})
+
+	got, err := client.Conversations.Update(context.Background(), "123", &ConversationUpdateSchema{Ttl: 3600})
+
+	want := &Conversation{
+		Id:           "123",
+		Conversation: []map[string]interface{}{{"user": "can you suggest an action series"}},
+		LastUpdated:  1694962465,
+		Ttl:          3600,
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestDocumentsService_SearchConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/collections/movies/documents/search", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "true", q.Get("conversation"))
+		assert.Equal(t, "conv-model-1", q.Get("conversation_model_id"))
+		assert.Equal(t, "123", q.Get("conversation_id"))
+		fmt.Fprint(w, `{
+			"conversation": {
+				"answer": "I would suggest \"Fast & Furious\".",
+				"conversation_history": [{"user": "can you suggest an action series"}],
+				"conversation_id": "123",
+				"query": "can you suggest an action series"
+			},
+			"found": 0,
+			"hits": []
+		}`)
+	})
+
+	got, err := client.Documents.Search(context.Background(), "movies", &SearchParameters{
+		Q:                   "can you suggest an action series",
+		QueryBy:             "embedding",
+		Conversation:        Bool(true),
+		ConversationModelId: String("conv-model-1"),
+		ConversationId:      String("123"),
+	})
+
+	want := &SearchResultConversation{
+		Answer:              "I would suggest \"Fast & Furious\".",
+		ConversationHistory: []map[string]interface{}{{"user": "can you suggest an action series"}},
+		ConversationId:      "123",
+		Query:               "can you suggest an action series",
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got.Conversation)
+}
+
+func TestDocumentsService_MultiSearchConversation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/multi_search", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "true", q.Get("conversation"))
+		assert.Equal(t, "conv-model-1", q.Get("conversation_model_id"))
+		assert.Equal(t, "embedding", q.Get("query_by"))
+		fmt.Fprint(w, `{
+			"conversation": {
+				"answer": "Fast & Furious",
+				"conversation_history": [],
+				"conversation_id": "123",
+				"query": "action series"
+			},
+			"results": [{"found": 0, "hits": []}]
+		}`)
+	})
+
+	got, err := client.Documents.MultiSearch(context.Background(), &MultiSearchSearchesParameter{
+		Searches: []MultiSearchCollectionParameters{{Collection: "movies", Q: String("action series")}},
+	}, &MultiSearchParameters{
+		QueryBy:             String("embedding"),
+		Conversation:        Bool(true),
+		ConversationModelId: String("conv-model-1"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "123", got.Conversation.ConversationId)
+	assert.Len(t, got.Results, 1)
+}
@@ -0,0 +1,165 @@
+package typesense
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultCredentialsCheckInterval = 10 * time.Second
+
+// CredentialProvider provides the API key of a Client. It is consulted for
+// every request, so implementations that fetch the key from elsewhere should
+// cache it.
+type CredentialProvider interface {
+	APIKey() (string, error)
+}
+
+// CredentialRefresher is implemented by CredentialProviders that cache the
+// key. When the server rejects a key with 401 Unauthorized, the client calls
+// Refresh and, if the key changed, retries the request once with the new key.
+type CredentialRefresher interface {
+	Refresh() error
+}
+
+// StaticCredentials is a fixed API key.
+type StaticCredentials string
+
+// APIKey implements CredentialProvider.
+func (s StaticCredentials) APIKey() (string, error) {
+	return string(s), nil
+}
+
+// EnvCredentials is the name of an environment variable holding the API key.
+// The variable is read for every request.
+type EnvCredentials string
+
+// APIKey implements CredentialProvider.
+func (e EnvCredentials) APIKey() (string, error) {
+	key := os.Getenv(string(e))
+	if key == "" {
+		return "", fmt.Errorf("typesense: environment variable %s is not set", string(e))
+	}
+	return key, nil
+}
+
+// CredentialFunc is a function providing the API key. Wrap it with
+// NewCachedCredentials if it is expensive.
+type CredentialFunc func() (string, error)
+
+// APIKey implements CredentialProvider.
+func (f CredentialFunc) APIKey() (string, error) {
+	return f()
+}
+
+// CachedCredentials caches the key of another CredentialProvider for a TTL.
+type CachedCredentials struct {
+	provider CredentialProvider
+	ttl      time.Duration
+
+	mu        sync.Mutex
+	key       string
+	fetchedAt time.Time
+	now       func() time.Time
+}
+
+// NewCachedCredentials returns a CredentialProvider calling provider at most
+// once per ttl, and again after a refresh.
+func NewCachedCredentials(provider CredentialProvider, ttl time.Duration) *CachedCredentials {
+	return &CachedCredentials{provider: provider, ttl: ttl, now: time.Now}
+}
+
+// APIKey implements CredentialProvider.
+func (c *CachedCredentials) APIKey() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.key != "" && c.now().Sub(c.fetchedAt) < c.ttl {
+		return c.key, nil
+	}
+	key, err := c.provider.APIKey()
+	if err != nil {
+		return "", err
+	}
+	c.key, c.fetchedAt = key, c.now()
+	return key, nil
+}
+
+// Refresh implements CredentialRefresher. It drops the cached key and
+// refreshes the wrapped provider if it caches too.
+func (c *CachedCredentials) Refresh() error {
+	c.mu.Lock()
+	c.key = ""
+	c.mu.Unlock()
+	if r, ok := c.provider.(CredentialRefresher); ok {
+		return r.Refresh()
+	}
+	return nil
+}
+
+// FileCredentials reads the API key from a file, such as a mounted Kubernetes
+// secret, and rereads it when the file changes.
+type FileCredentials struct {
+	path string
+
+	// CheckInterval is the minimum time between two checks whether the file
+	// changed. Default: 10s
+	CheckInterval time.Duration
+
+	mu        sync.Mutex
+	key       string
+	modTime   time.Time
+	checkedAt time.Time
+	now       func() time.Time
+}
+
+// NewFileCredentials returns a CredentialProvider reading the key from the
+// file at path. Surrounding white space is trimmed from the key.
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{
+		path:          path,
+		CheckInterval: defaultCredentialsCheckInterval,
+		now:           time.Now,
+	}
+}
+
+// APIKey implements CredentialProvider.
+func (f *FileCredentials) APIKey() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := f.now()
+	if f.key != "" && now.Sub(f.checkedAt) < f.CheckInterval {
+		return f.key, nil
+	}
+
+	fi, err := os.Stat(f.path)
+	if err != nil {
+		return "", err
+	}
+	f.checkedAt = now
+	if f.key != "" && fi.ModTime().Equal(f.modTime) {
+		return f.key, nil
+	}
+
+	b, err := os.ReadFile(f.path)
+	if err != nil {
+		return "", err
+	}
+	key := strings.TrimSpace(string(b))
+	if key == "" {
+		return "", errors.New("typesense: empty api key file " + f.path)
+	}
+	f.key, f.modTime = key, fi.ModTime()
+	return key, nil
+}
+
+// Refresh implements CredentialRefresher. The file is reread by the next call
+// of APIKey.
+func (f *FileCredentials) Refresh() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.key = ""
+	return nil
+}
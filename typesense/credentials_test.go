@@ -0,0 +1,136 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv("TEST_TYPESENSE_API_KEY", "xyz")
+	key, err := EnvCredentials("TEST_TYPESENSE_API_KEY").APIKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "xyz", key)
+
+	_, err = EnvCredentials("TEST_TYPESENSE_UNSET").APIKey()
+	assert.ErrorContains(t, err, "TEST_TYPESENSE_UNSET is not set")
+}
+
+func TestCachedCredentials(t *testing.T) {
+	calls := 0
+	c := NewCachedCredentials(CredentialFunc(func() (string, error) {
+		calls++
+		return fmt.Sprintf("key-%d", calls), nil
+	}), time.Minute)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	for _, want := range []string{"key-1", "key-1"} {
+		key, err := c.APIKey()
+		assert.NoError(t, err)
+		assert.Equal(t, want, key)
+	}
+
+	now = now.Add(time.Minute)
+	key, _ := c.APIKey()
+	assert.Equal(t, "key-2", key)
+
+	assert.NoError(t, c.Refresh())
+	key, _ = c.APIKey()
+	assert.Equal(t, "key-3", key)
+}
+
+func TestFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, os.WriteFile(path, []byte("key-1\n"), 0o600))
+
+	f := NewFileCredentials(path)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	f.now = func() time.Time { return now }
+
+	key, err := f.APIKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", key)
+
+	require.NoError(t, os.WriteFile(path, []byte("key-2\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, now, now.Add(time.Second)))
+
+	key, _ = f.APIKey()
+	assert.Equal(t, "key-1", key, "file is not checked before CheckInterval")
+
+	now = now.Add(f.CheckInterval)
+	key, _ = f.APIKey()
+	assert.Equal(t, "key-2", key)
+
+	require.NoError(t, os.WriteFile(path, []byte(" "), 0o600))
+	require.NoError(t, f.Refresh())
+	_, err = f.APIKey()
+	assert.ErrorContains(t, err, "empty api key file")
+}
+
+func TestClient_RefreshCredentialsOn401(t *testing.T) {
+	var valid = "key-1"
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(headerAPIKEy)
+		got = append(got, key)
+		if key != valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message": "Forbidden - a valid x-typesense-api-key header must be sent."}`)
+			return
+		}
+		fmt.Fprint(w, `{"name": "companies"}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, os.WriteFile(path, []byte("key-1"), 0o600))
+	credentials := NewFileCredentials(path)
+	credentials.CheckInterval = time.Hour
+
+	client, err := NewClientWithCredentials(nil, server.URL, credentials)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Collections.Get(ctx, "companies")
+	require.NoError(t, err)
+
+	// the key is rotated on the server and in the file
+	valid = "key-2"
+	require.NoError(t, os.WriteFile(path, []byte("key-2"), 0o600))
+
+	_, err = client.Collections.Create(ctx, &CollectionSchema{Name: "companies", Fields: []*Field{{Name: "name", Type: "string"}}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key-1", "key-1", "key-2"}, got)
+
+	// a key that stays invalid is not retried
+	valid = "key-3"
+	_, err = client.Collections.Get(ctx, "companies")
+	var apiErr *ApiError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, []string{"key-1", "key-1", "key-2", "key-2"}, got)
+}
+
+func TestClient_CredentialsError(t *testing.T) {
+	client, err := NewClientWithCredentials(nil, "", CredentialFunc(func() (string, error) {
+		return "", errors.New("vault sealed")
+	}))
+	require.NoError(t, err)
+
+	_, err = client.Collections.Get(context.Background(), "companies")
+	assert.ErrorContains(t, err, "vault sealed")
+	assert.Empty(t, client.APIKey())
+
+	client.SetCredentials(StaticCredentials("xyz"))
+	assert.Equal(t, "xyz", client.APIKey())
+}
@@ -3,6 +3,7 @@ package typesense
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 type DirtyValuesOptions string
@@ -144,7 +145,6 @@ type ExportDocumentsParams struct {
 	IncludeFields string `url:"include_fields,omitempty"`
 }
 
-// TODO: handle jsonl
 func (s *DocumentsService) Export(ctx context.Context, collectionName string, opts *ExportDocumentsParams) ([]map[string]interface{}, error) {
 	u := fmt.Sprintf("/collections/%s/documents/export", collectionName)
 	u, err := addOptions(u, opts)
@@ -164,11 +164,27 @@ func (s *DocumentsService) Export(ctx context.Context, collectionName string, op
 	return res, nil
 }
 
+// ExportJSONL streams the documents of a collection to w as newline
+// delimited JSON.
+func (s *DocumentsService) ExportJSONL(ctx context.Context, collectionName string, w io.Writer, opts *ExportDocumentsParams) error {
+	u := fmt.Sprintf("/collections/%s/documents/export", collectionName)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Do(ctx, req, w)
+}
+
 type ImportDocumentsParams struct {
-	Action                   *string `json:"action,omitempty"`
-	BatchSize                *int    `json:"batch_size,omitempty"`
-	DirtyValues              *string `json:"dirty_values,omitempty"`
-	RemoteEmbeddingBatchSize *int    `json:"remote_embedding_batch_size,omitempty"`
+	Action                   *string `url:"action,omitempty"`
+	BatchSize                *int    `url:"batch_size,omitempty"`
+	DirtyValues              *string `url:"dirty_values,omitempty"`
+	RemoteEmbeddingBatchSize *int    `url:"remote_embedding_batch_size,omitempty"`
 }
 
 type ImportDocumentResponse struct {
@@ -178,7 +194,6 @@ type ImportDocumentResponse struct {
 	Error    *string `json:"error"`
 }
 
-// TODO: handle jsonl
 func (s *DocumentsService) Import(ctx context.Context, collectionName string, body []map[string]interface{}, opts *ImportDocumentsParams) ([]*ImportDocumentResponse, error) {
 	u := fmt.Sprintf("/collections/%s/documents/import", collectionName)
 	u, err := addOptions(u, opts)
@@ -198,24 +213,26 @@ func (s *DocumentsService) Import(ctx context.Context, collectionName string, bo
 	return res, nil
 }
 
-// func (s *DocumentsService) ImportFromReader(ctx context.Context, collectionName string, body io.Reader, opts *ImportDocumentsParams) (io.ReadCloser, error) {
-// 	u := fmt.Sprintf("/collections/%s/documents/import", collectionName)
-// 	u, err := addOptions(u, opts)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	req, err := s.client.NewRequest("POST", u, body)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	var res []*ImportDocumentResponse
-// 	err = s.client.Do(ctx, req, &res)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return res, nil
-// }
+// ImportJSONL imports the newline delimited JSON documents read from r
+// without buffering them in memory.
+func (s *DocumentsService) ImportJSONL(ctx context.Context, collectionName string, r io.Reader, opts *ImportDocumentsParams) ([]*ImportDocumentResponse, error) {
+	u := fmt.Sprintf("/collections/%s/documents/import", collectionName)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*ImportDocumentResponse
+	err = s.client.Do(ctx, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
 func (s *DocumentsService) Search(ctx context.Context, collectionName string, opts *SearchParameters) (*SearchResult, error) {
 	u := fmt.Sprintf("/collections/%s/documents/search", collectionName)
@@ -1,9 +1,12 @@
 package typesense
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -262,6 +265,88 @@ func TestDocumentsService_Import(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestDocumentsService_ImportWithParams(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/collections/companies/documents/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "action=emplace&batch_size=100&dirty_values=coerce_or_drop&remote_embedding_batch_size=50", r.URL.RawQuery)
+		fmt.Fprint(w, `{"success": true}`)
+	})
+
+	ctx := context.Background()
+	opts := &ImportDocumentsParams{
+		Action:                   String("emplace"),
+		BatchSize:                Int(100),
+		DirtyValues:              String("coerce_or_drop"),
+		RemoteEmbeddingBatchSize: Int(50),
+	}
+	body := []map[string]interface{}{{"id": "1", "company_name": "Stark Industries"}}
+	_, err := client.Documents.Import(ctx, "companies", body, opts)
+	assert.NoError(t, err)
+}
+
+func TestDocumentsService_ExportJSONL(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	collectionName := "companies"
+	u := fmt.Sprintf("/collections/%s/documents/export", collectionName)
+	mux.HandleFunc(u, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "num_employees:>1000", r.URL.Query().Get("filter_by"))
+		fmt.Fprint(w, `{"company_name": "Stark Industries", "num_employees": 5215}
+{"company_name": "Future Technology", "num_employees": 1232}`)
+	})
+
+	ctx := context.Background()
+	opts := &ExportDocumentsParams{
+		FilterBy: "num_employees:>1000",
+	}
+	var buf bytes.Buffer
+	err := client.Documents.ExportJSONL(ctx, collectionName, &buf, opts)
+	assert.NoError(t, err)
+
+	want := `{"company_name": "Stark Industries", "num_employees": 5215}
+{"company_name": "Future Technology", "num_employees": 1232}`
+	assert.Equal(t, want, buf.String())
+}
+
+func TestDocumentsService_ImportJSONL(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	body := `{"id": "1", "company_name": "Stark Industries"}
+{"id": "2", "company_name": "Orbit Inc."}
+`
+	collectionName := "companies"
+	u := fmt.Sprintf("/collections/%s/documents/import", collectionName)
+	mux.HandleFunc(u, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "upsert", r.URL.Query().Get("action"))
+		got, _ := io.ReadAll(r.Body)
+		assert.Equal(t, body, string(got))
+		fmt.Fprint(w, `
+			{"success": true}
+			{"success": true}
+		`)
+	})
+
+	want := []*ImportDocumentResponse{
+		{Success: true},
+		{Success: true},
+	}
+
+	ctx := context.Background()
+	opts := &ImportDocumentsParams{
+		Action: String("upsert"),
+	}
+	got, err := client.Documents.ImportJSONL(ctx, collectionName, strings.NewReader(body), opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, want, got)
+}
+
 func TestDocumentsService_Import_With_Partial_Error(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
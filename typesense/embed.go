@@ -0,0 +1,136 @@
+package typesense
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Built-in embedding models that Typesense downloads and runs itself.
+const (
+	ModelAllMiniLML12V2 = "ts/all-MiniLM-L12-v2"
+	ModelE5Small        = "ts/e5-small"
+)
+
+// Embed returns an EmbedConfig generating the embedding of a float[] field
+// from the given fields with model.
+func Embed(model *ModelConfig, from ...string) *EmbedConfig {
+	return &EmbedConfig{From: from, ModelConfig: model}
+}
+
+// BuiltinModel returns the configuration of a built-in model such as
+// ModelAllMiniLML12V2 or ModelE5Small.
+func BuiltinModel(name string) *ModelConfig {
+	return &ModelConfig{ModelName: &name}
+}
+
+// OpenAIModel returns the configuration of an OpenAI embedding model, e.g.
+// OpenAIModel("text-embedding-ada-002", apiKey).
+func OpenAIModel(name, apiKey string) *ModelConfig {
+	return &ModelConfig{ModelName: String(withProvider("openai", name)), ApiKey: &apiKey}
+}
+
+// CustomModel returns the configuration of a model served by an
+// OpenAI-compatible API at url. apiKey may be empty if the API does not
+// require one.
+func CustomModel(name, url, apiKey string) *ModelConfig {
+	m := &ModelConfig{ModelName: String(withProvider("openai", name)), Url: &url}
+	if apiKey != "" {
+		m.ApiKey = &apiKey
+	}
+	return m
+}
+
+// PaLMModel returns the configuration of a Google PaLM embedding model, e.g.
+// PaLMModel("embedding-gecko-001", apiKey).
+func PaLMModel(name, apiKey string) *ModelConfig {
+	return &ModelConfig{ModelName: String(withProvider("google", name)), ApiKey: &apiKey}
+}
+
+// VertexCredentials are the OAuth credentials of a Google Cloud project used
+// to call Vertex AI.
+type VertexCredentials struct {
+	AccessToken  string
+	RefreshToken string
+	ClientId     string
+	ClientSecret string
+}
+
+// VertexModel returns the configuration of a Vertex AI embedding model of the
+// Google Cloud project, e.g. VertexModel("embedding-gecko-001", "my-project", creds).
+func VertexModel(name, projectId string, creds VertexCredentials) *ModelConfig {
+	return &ModelConfig{
+		ModelName:    String(withProvider("gcp", name)),
+		ProjectId:    &projectId,
+		AccessToken:  &creds.AccessToken,
+		RefreshToken: &creds.RefreshToken,
+		ClientId:     &creds.ClientId,
+		ClientSecret: &creds.ClientSecret,
+	}
+}
+
+// WithPrefixes sets the prefixes added to documents and queries before they
+// are embedded, e.g. "passage:" and "query:" for ModelE5Small, and returns m.
+func (m *ModelConfig) WithPrefixes(indexing, query string) *ModelConfig {
+	m.IndexingPrefix = &indexing
+	m.QueryPrefix = &query
+	return m
+}
+
+func withProvider(provider, name string) string {
+	if strings.HasPrefix(name, provider+"/") {
+		return name
+	}
+	return provider + "/" + name
+}
+
+// validate checks that the model configuration has the settings its provider
+// requires. The from fields are checked by CollectionSchema.Validate as they
+// depend on the rest of the schema.
+func (e *EmbedConfig) validate(errs *ValidationErrors, path string) {
+	m := e.ModelConfig
+	if m == nil {
+		errs.add(path+".model_config", "must be set")
+		return
+	}
+	path += ".model_config"
+	if m.ModelName == nil || *m.ModelName == "" {
+		errs.add(path+".model_name", "must not be empty")
+		return
+	}
+	name := *m.ModelName
+
+	required := func(field string, v *string) {
+		if v == nil || *v == "" {
+			errs.add(path+"."+field, "is required for model %s", name)
+		}
+	}
+	provider, _, _ := strings.Cut(name, "/")
+	switch provider {
+	case "openai":
+		if m.Url == nil {
+			required("api_key", m.ApiKey)
+		}
+	case "google":
+		required("api_key", m.ApiKey)
+	case "gcp":
+		required("project_id", m.ProjectId)
+		required("access_token", m.AccessToken)
+		required("refresh_token", m.RefreshToken)
+		required("client_id", m.ClientId)
+		required("client_secret", m.ClientSecret)
+	}
+	if m.Url != nil && provider != "openai" {
+		errs.add(path+".url", "is only supported for openai models, not %s", name)
+	}
+}
+
+// validateEmbeds checks the embedding configuration of fields.
+func validateEmbeds(fields []*Field) error {
+	var errs ValidationErrors
+	for i, f := range fields {
+		if f != nil && f.Embed != nil {
+			f.Embed.validate(&errs, fmt.Sprintf("fields[%d].embed", i))
+		}
+	}
+	return errs.err()
+}
@@ -0,0 +1,165 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelConfig_JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		model *ModelConfig
+		want  string
+	}{
+		{
+			name:  "builtin with prefixes",
+			model: BuiltinModel(ModelE5Small).WithPrefixes("passage:", "query:"),
+			want:  `{"indexing_prefix":"passage:","model_name":"ts/e5-small","query_prefix":"query:"}`,
+		},
+		{
+			name:  "openai",
+			model: OpenAIModel("text-embedding-ada-002", "sk-1"),
+			want:  `{"api_key":"sk-1","model_name":"openai/text-embedding-ada-002"}`,
+		},
+		{
+			name:  "custom url",
+			model: CustomModel("openai/my-model", "http://localhost:8000", ""),
+			want:  `{"model_name":"openai/my-model","url":"http://localhost:8000"}`,
+		},
+		{
+			name:  "palm",
+			model: PaLMModel("embedding-gecko-001", "key"),
+			want:  `{"api_key":"key","model_name":"google/embedding-gecko-001"}`,
+		},
+		{
+			name: "vertex",
+			model: VertexModel("embedding-gecko-001", "my-project", VertexCredentials{
+				AccessToken: "at", RefreshToken: "rt", ClientId: "id", ClientSecret: "secret",
+			}),
+			want: `{"access_token":"at","client_id":"id","client_secret":"secret",` +
+				`"model_name":"gcp/embedding-gecko-001","project_id":"my-project","refresh_token":"rt"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.model)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(b))
+
+			var errs ValidationErrors
+			Embed(tt.model, "title").validate(&errs, "embed")
+			assert.Empty(t, errs)
+		})
+	}
+}
+
+func TestEmbedConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		embed *EmbedConfig
+		want  []string
+	}{
+		{
+			name:  "no model",
+			embed: &EmbedConfig{From: []string{"title"}},
+			want:  []string{"embed.model_config: must be set"},
+		},
+		{
+			name:  "no model name",
+			embed: Embed(&ModelConfig{}, "title"),
+			want:  []string{"embed.model_config.model_name: must not be empty"},
+		},
+		{
+			name:  "openai without key",
+			embed: Embed(&ModelConfig{ModelName: String("openai/text-embedding-ada-002")}, "title"),
+			want:  []string{"embed.model_config.api_key: is required for model openai/text-embedding-ada-002"},
+		},
+		{
+			name:  "vertex without credentials",
+			embed: Embed(&ModelConfig{ModelName: String("gcp/embedding-gecko-001"), ProjectId: String("p")}, "title"),
+			want: []string{
+				"embed.model_config.access_token: is required for model gcp/embedding-gecko-001",
+				"embed.model_config.refresh_token: is required for model gcp/embedding-gecko-001",
+				"embed.model_config.client_id: is required for model gcp/embedding-gecko-001",
+				"embed.model_config.client_secret: is required for model gcp/embedding-gecko-001",
+			},
+		},
+		{
+			name:  "url for builtin model",
+			embed: Embed(&ModelConfig{ModelName: String(ModelE5Small), Url: String("http://localhost")}, "title"),
+			want:  []string{"embed.model_config.url: is only supported for openai models, not ts/e5-small"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs ValidationErrors
+			tt.embed.validate(&errs, "embed")
+
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCollectionsService_CreateEmbed(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/collections", func(w http.ResponseWriter, r *http.Request) {
+		var schema map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&schema))
+		field := schema["fields"].([]interface{})[1].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{
+			"from": []interface{}{"title"},
+			"model_config": map[string]interface{}{
+				"model_name":      "ts/e5-small",
+				"indexing_prefix": "passage:",
+				"query_prefix":    "query:",
+			},
+		}, field["embed"])
+		fmt.Fprint(w, `{"name": "products", "num_documents": 0}`)
+	})
+
+	schema := &CollectionSchema{Name: "products", Fields: []*Field{
+		{Name: "title", Type: FieldTypeString},
+		{Name: "embedding", Type: FieldTypeFloatArray, Embed: Embed(BuiltinModel(ModelE5Small).WithPrefixes("passage:", "query:"), "title")},
+	}}
+	_, err := client.Collections.Create(context.Background(), schema)
+	require.NoError(t, err)
+
+	schema.Fields[1].Embed = Embed(&ModelConfig{ModelName: String("openai/text-embedding-ada-002")}, "title")
+	_, err = client.Collections.Create(context.Background(), schema)
+	var errs ValidationErrors
+	require.True(t, errors.As(err, &errs))
+	assert.EqualError(t, err, "fields[1].embed.model_config.api_key: is required for model openai/text-embedding-ada-002")
+}
+
+func TestCollectionsService_NilBody(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/collections", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "Bad JSON."}`)
+	})
+	mux.HandleFunc("/collections/products", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "Bad JSON."}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Collections.Create(ctx, nil)
+	assert.ErrorContains(t, err, "Bad JSON.")
+	_, err = client.Collections.Update(ctx, "products", nil)
+	assert.ErrorContains(t, err, "Bad JSON.")
+}
@@ -0,0 +1,286 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultEventBatchSize     = 100
+	defaultEventFlushInterval = time.Second
+	defaultEventQueueSize     = 10000
+	defaultEventMaxRetries    = 3
+	defaultEventRetryBackoff  = 100 * time.Millisecond
+)
+
+// EventBatcher buffers analytics events in memory and sends them in the
+// background, so events can be recorded from hot request paths without
+// waiting for the server. Queued events are flushed once BatchSize of them
+// are queued or every FlushInterval, whichever comes first. When the queue is
+// full, new events are dropped and counted rather than blocking the caller.
+//
+// The analytics events endpoint accepts a single event per request, so a
+// flush sends its events one request each, in order. Batching bounds how
+// often the sender wakes up and keeps callers off the network; it does not
+// reduce the number of requests.
+//
+// The configuration fields must be set before the first call to Add. Sizes
+// and durations that are not positive are replaced by their defaults.
+type EventBatcher struct {
+	events AnalyticsEventsAPI
+
+	// BatchSize is the number of queued events that triggers a flush.
+	// Default: 100
+	BatchSize int
+
+	// FlushInterval is the maximum time an event stays queued.
+	// Default: 1s
+	FlushInterval time.Duration
+
+	// QueueSize is the number of events buffered before Add drops them.
+	// Default: 10000
+	QueueSize int
+
+	// MaxRetries is the number of times an event is resent after a network
+	// error, a 429 or a 5xx response. Default: 3
+	MaxRetries int
+
+	// RetryBackoff is the delay before the first retry. It doubles with each
+	// further retry. Default: 100ms
+	RetryBackoff time.Duration
+
+	// OnError, if set, is called with each event that could not be sent.
+	OnError func(ev *AnalyticsEvent, err error)
+
+	startOnce sync.Once
+	mu        sync.RWMutex
+	closed    bool
+	queue     chan *AnalyticsEvent
+	flushes   chan chan struct{}
+	done      chan struct{}
+	ctx       context.Context
+	cancel    context.CancelFunc
+
+	sent    atomic.Int64
+	failed  atomic.Int64
+	dropped atomic.Int64
+}
+
+// EventBatcherStats are the counters of an EventBatcher.
+type EventBatcherStats struct {
+	// Sent is the number of events accepted by the server.
+	Sent int64
+
+	// Failed is the number of events that could not be sent, including
+	// after retries.
+	Failed int64
+
+	// Dropped is the number of events discarded by Add because the queue
+	// was full or the batcher closed.
+	Dropped int64
+}
+
+func NewEventBatcher(events AnalyticsEventsAPI) *EventBatcher {
+	return &EventBatcher{
+		events:        events,
+		BatchSize:     defaultEventBatchSize,
+		FlushInterval: defaultEventFlushInterval,
+		QueueSize:     defaultEventQueueSize,
+		MaxRetries:    defaultEventMaxRetries,
+		RetryBackoff:  defaultEventRetryBackoff,
+	}
+}
+
+// Add queues ev without blocking. It reports false if ev was dropped because
+// the queue is full or the batcher is closed.
+func (b *EventBatcher) Add(ev *AnalyticsEvent) bool {
+	b.start()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		b.dropped.Add(1)
+		return false
+	}
+	select {
+	case b.queue <- ev:
+		return true
+	default:
+		b.dropped.Add(1)
+		return false
+	}
+}
+
+// Flush sends the events queued so far and waits until they are sent or ctx
+// is done.
+func (b *EventBatcher) Flush(ctx context.Context) error {
+	b.start()
+
+	ack := make(chan struct{})
+	select {
+	case b.flushes <- ack:
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-ack:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting events and sends the queued ones. If ctx is done
+// first, the remaining events are abandoned and ctx's error is returned.
+func (b *EventBatcher) Close(ctx context.Context) error {
+	b.start()
+
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		close(b.queue)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		<-b.done
+		return ctx.Err()
+	}
+}
+
+// Stats returns the current counters.
+func (b *EventBatcher) Stats() EventBatcherStats {
+	return EventBatcherStats{
+		Sent:    b.sent.Load(),
+		Failed:  b.failed.Load(),
+		Dropped: b.dropped.Load(),
+	}
+}
+
+func (b *EventBatcher) start() {
+	b.startOnce.Do(func() {
+		if b.BatchSize <= 0 {
+			b.BatchSize = defaultEventBatchSize
+		}
+		if b.FlushInterval <= 0 {
+			b.FlushInterval = defaultEventFlushInterval
+		}
+		if b.QueueSize <= 0 {
+			b.QueueSize = defaultEventQueueSize
+		}
+		if b.MaxRetries < 0 {
+			b.MaxRetries = 0
+		}
+		if b.RetryBackoff <= 0 {
+			b.RetryBackoff = defaultEventRetryBackoff
+		}
+		b.queue = make(chan *AnalyticsEvent, b.QueueSize)
+		b.flushes = make(chan chan struct{})
+		b.done = make(chan struct{})
+		b.ctx, b.cancel = context.WithCancel(context.Background())
+		go b.run()
+	})
+}
+
+func (b *EventBatcher) run() {
+	defer close(b.done)
+	defer b.cancel()
+
+	ticker := time.NewTicker(b.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*AnalyticsEvent, 0, b.BatchSize)
+	for {
+		select {
+		case ev, ok := <-b.queue:
+			if !ok {
+				b.sendEach(batch)
+				return
+			}
+			batch = append(batch, ev)
+			if len(batch) >= b.BatchSize {
+				b.sendEach(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			b.sendEach(batch)
+			batch = batch[:0]
+		case ack := <-b.flushes:
+			batch = b.drain(batch)
+			b.sendEach(batch)
+			batch = batch[:0]
+			close(ack)
+		}
+	}
+}
+
+// drain appends the events currently in the queue to batch.
+func (b *EventBatcher) drain(batch []*AnalyticsEvent) []*AnalyticsEvent {
+	for {
+		select {
+		case ev, ok := <-b.queue:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, ev)
+		default:
+			return batch
+		}
+	}
+}
+
+// sendEach sends the events of batch one request each.
+func (b *EventBatcher) sendEach(batch []*AnalyticsEvent) {
+	for _, ev := range batch {
+		if err := b.sendEvent(ev); err != nil {
+			b.failed.Add(1)
+			if b.OnError != nil {
+				b.OnError(ev, err)
+			}
+			continue
+		}
+		b.sent.Add(1)
+	}
+}
+
+func (b *EventBatcher) sendEvent(ev *AnalyticsEvent) error {
+	backoff := b.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		_, err := b.events.Create(b.ctx, ev)
+		if err == nil || attempt >= b.MaxRetries || !retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-b.ctx.Done():
+			timer.Stop()
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// retryable reports whether a request that failed with err may succeed when
+// sent again.
+func retryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBatcher(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var (
+		mu       sync.Mutex
+		received []*AnalyticsEvent
+		attempts = map[string]int{}
+	)
+	mux.HandleFunc("/analytics/events", func(w http.ResponseWriter, r *http.Request) {
+		var ev AnalyticsEvent
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ev))
+
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[ev.Data.DocId]++
+		switch {
+		case ev.Data.DocId == "flaky" && attempts["flaky"] < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"message": "Not Ready or Lagging"}`)
+		case ev.Name == "":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message": "Event name is missing."}`)
+		default:
+			received = append(received, &ev)
+			fmt.Fprint(w, `{"ok": true}`)
+		}
+	})
+
+	var failed []*AnalyticsEvent
+	b := NewEventBatcher(client.AnalyticsEvents)
+	b.BatchSize = 2
+	b.FlushInterval = time.Hour
+	b.RetryBackoff = time.Millisecond
+	b.OnError = func(ev *AnalyticsEvent, err error) { failed = append(failed, ev) }
+
+	assert.True(t, b.Add(ClickEvent("products_click", "shoe", "1", "u1", 1)))
+	assert.True(t, b.Add(ConversionEvent("products_purchase", "flaky", "u1")))
+	assert.True(t, b.Add(VisitEvent("", "2", "u1")))
+	require.NoError(t, b.Flush(context.Background()))
+
+	mu.Lock()
+	assert.Len(t, received, 2)
+	assert.Equal(t, ClickEvent("products_click", "shoe", "1", "u1", 1), received[0])
+	assert.Equal(t, 3, attempts["flaky"], "retried on 503")
+	assert.Equal(t, 1, attempts["2"], "not retried on 400")
+	mu.Unlock()
+	assert.Equal(t, []*AnalyticsEvent{VisitEvent("", "2", "u1")}, failed)
+
+	require.NoError(t, b.Close(context.Background()))
+	assert.False(t, b.Add(CustomEvent("late", "3", "u1")))
+	assert.Equal(t, EventBatcherStats{Sent: 2, Failed: 1, Dropped: 1}, b.Stats())
+}
+
+func TestEventBatcher_Backpressure(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	release := make(chan struct{})
+	mux.HandleFunc("/analytics/events", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		fmt.Fprint(w, `{"ok": true}`)
+	})
+
+	b := NewEventBatcher(client.AnalyticsEvents)
+	b.BatchSize = 1
+	b.QueueSize = 2
+	b.FlushInterval = time.Hour
+
+	// the first event is taken by the sender, which blocks on the server;
+	// two more fill the queue and the rest are dropped
+	require.True(t, b.Add(SearchEvent("shoe", "products")))
+	require.Eventually(t, func() bool { return len(b.queue) == 0 }, time.Second, time.Millisecond)
+	added := 0
+	for i := 0; i < 5; i++ {
+		if b.Add(SearchEvent("shoe", "products")) {
+			added++
+		}
+	}
+	assert.Equal(t, 2, added)
+	assert.Equal(t, int64(3), b.Stats().Dropped)
+
+	close(release)
+	require.NoError(t, b.Close(context.Background()))
+	assert.Equal(t, int64(3), b.Stats().Sent)
+}
+
+func TestEventBatcher_InvalidConfig(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/analytics/events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ok": true}`)
+	})
+
+	b := NewEventBatcher(client.AnalyticsEvents)
+	b.BatchSize = 0
+	b.QueueSize = 0
+	b.FlushInterval = 0
+	b.RetryBackoff = -time.Second
+
+	for i := 0; i < 10; i++ {
+		require.True(t, b.Add(SearchEvent("shoe", "products")))
+	}
+	assert.Equal(t, defaultEventQueueSize, cap(b.queue))
+	assert.Equal(t, defaultEventFlushInterval, b.FlushInterval)
+	require.NoError(t, b.Close(context.Background()))
+	assert.Equal(t, EventBatcherStats{Sent: 10}, b.Stats())
+}
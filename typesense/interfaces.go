@@ -0,0 +1,192 @@
+package typesense
+
+import (
+	"context"
+	"io"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.4.0 -source=interfaces.go -destination=mocks/mocks.go -package=mocks
+
+// ClientAPI is the interface implemented by Client. Applications can depend on
+// it instead of *Client to substitute the services in tests.
+type ClientAPI interface {
+	CollectionsAPI() CollectionsAPI
+	DocumentsAPI() DocumentsAPI
+	KeysAPI() KeysAPI
+	RateLimitsAPI() RateLimitsAPI
+	OperationsAPI() OperationsAPI
+	MetaAPI() MetaAPI
+	OverridesAPI() OverridesAPI
+	AliasesAPI() AliasesAPI
+	AnalyticsRulesAPI() AnalyticsRulesAPI
+	AnalyticsEventsAPI() AnalyticsEventsAPI
+	PresetsAPI() PresetsAPI
+	SynonymsAPI() SynonymsAPI
+	StopwordsAPI() StopwordsAPI
+	ConversationsAPI() ConversationsAPI
+}
+
+// CollectionsAPI is the interface implemented by CollectionsService.
+type CollectionsAPI interface {
+	List(ctx context.Context) ([]*CollectionSchema, error)
+	Create(ctx context.Context, body *CollectionSchema) (*Collection, error)
+	Get(ctx context.Context, collectionName string) (*Collection, error)
+	Update(ctx context.Context, collectionName string, body *CollectionUpdateSchema) (*CollectionUpdateSchema, error)
+	Delete(ctx context.Context, collectionName string) (*Collection, error)
+}
+
+// DocumentsAPI is the interface implemented by DocumentsService.
+type DocumentsAPI interface {
+	Create(ctx context.Context, collectionName string, body interface{}) (interface{}, error)
+	Get(ctx context.Context, collectionName, documentId string) (interface{}, error)
+	Update(ctx context.Context, collectionName, documentId string, body interface{}) (interface{}, error)
+	UpdateByQuery(ctx context.Context, collectionName, body interface{}, opts *UpdateOptions) (*UpdateByQueryResponse, error)
+	Delete(ctx context.Context, collectionName, documentId string) (interface{}, error)
+	DeleteByQuery(ctx context.Context, collectionName string, opts *DeleteOptions) (*DeleteByQueryResponse, error)
+	Export(ctx context.Context, collectionName string, opts *ExportDocumentsParams) ([]map[string]interface{}, error)
+	ExportJSONL(ctx context.Context, collectionName string, w io.Writer, opts *ExportDocumentsParams) error
+	Import(ctx context.Context, collectionName string, body []map[string]interface{}, opts *ImportDocumentsParams) ([]*ImportDocumentResponse, error)
+	ImportJSONL(ctx context.Context, collectionName string, r io.Reader, opts *ImportDocumentsParams) ([]*ImportDocumentResponse, error)
+	Search(ctx context.Context, collectionName string, opts *SearchParameters) (*SearchResult, error)
+	MultiSearch(ctx context.Context, body *MultiSearchSearchesParameter, opts *MultiSearchParameters) (*MultiSearchResult, error)
+}
+
+// KeysAPI is the interface implemented by KeysService.
+type KeysAPI interface {
+	List(ctx context.Context) (*ApiKeysResponse, error)
+	Create(ctx context.Context, body *ApiKeySchema) (*ApiKey, error)
+	Get(ctx context.Context, keyId int) (*ApiKey, error)
+	Delete(ctx context.Context, keyId int) (*ApiKey, error)
+}
+
+// RateLimitsAPI is the interface implemented by RateLimitsService.
+type RateLimitsAPI interface {
+	List(ctx context.Context) ([]*RateLimitRule, error)
+	ListActive(ctx context.Context) ([]*RateLimitStatus, error)
+	ListExceeds(ctx context.Context) ([]*RateLimitExceed, error)
+	Create(ctx context.Context, body *RateLimitRuleSchema) (*RateLimitResponse, error)
+	Get(ctx context.Context, id int) (*RateLimitRule, error)
+	Update(ctx context.Context, id int, body *RateLimitRuleSchema) (*RateLimitResponse, error)
+	Delete(ctx context.Context, id int) (*DeleteRateLimitResponse, error)
+	DeleteActive(ctx context.Context, id int) (*DeleteRateLimitResponse, error)
+	DeleteExceeds(ctx context.Context, id int) (*DeleteRateLimitResponse, error)
+}
+
+// OperationsAPI is the interface implemented by OperationsService.
+type OperationsAPI interface {
+	Snapshot(ctx context.Context, opts *TakeSnapshotParams) (*SuccessStatus, error)
+	Vote(ctx context.Context) (*SuccessStatus, error)
+	ClearCache(ctx context.Context) (*SuccessStatus, error)
+	CompactDB(ctx context.Context) (*SuccessStatus, error)
+	ResetPeers(ctx context.Context) (*SuccessStatus, error)
+}
+
+// MetaAPI is the interface implemented by MetaService.
+type MetaAPI interface {
+	Config(ctx context.Context, body *Config) (*SuccessStatus, error)
+	Metrics(ctx context.Context) (*Metrics, error)
+	Stats(ctx context.Context) (*Stats, error)
+	Debug(ctx context.Context) (*Debug, error)
+	Health(ctx context.Context) (*HealthStatus, error)
+	Status(ctx context.Context) (*NodeStatus, error)
+}
+
+// OverridesAPI is the interface implemented by OverridesService.
+type OverridesAPI interface {
+	List(ctx context.Context, collectionName string) (*SearchOverridesResponse, error)
+	Get(ctx context.Context, collectionName, overrideId string) (*SearchOverride, error)
+	Upsert(ctx context.Context, collectionName, overrideId string, body *SearchOverrideSchema) (*SearchOverride, error)
+	Delete(ctx context.Context, collectionName, overrideId string) (*DeleteOverrideResponse, error)
+}
+
+// AliasesAPI is the interface implemented by AliasesService.
+type AliasesAPI interface {
+	List(ctx context.Context) ([]*CollectionAlias, error)
+	Upsert(ctx context.Context, aliasName string, body *CollectionAliasSchema) (*CollectionAlias, error)
+	Get(ctx context.Context, aliasName string) (*CollectionAlias, error)
+	Delete(ctx context.Context, aliasName string) (*CollectionAlias, error)
+}
+
+// AnalyticsRulesAPI is the interface implemented by AnalyticsRulesService.
+type AnalyticsRulesAPI interface {
+	List(ctx context.Context) (*AnalyticsRuleListResponse, error)
+	Create(ctx context.Context, body *AnalyticsRule) (*AnalyticsRule, error)
+	Get(ctx context.Context, ruleName string) (*AnalyticsRule, error)
+	Upsert(ctx context.Context, ruleName string, body *AnalyticsRuleUpsertSchema) (*AnalyticsRule, error)
+	Delete(ctx context.Context, ruleName string) (*AnalyticsRuleDeleteResponse, error)
+}
+
+// AnalyticsEventsAPI is the interface implemented by AnalyticsEventsService.
+type AnalyticsEventsAPI interface {
+	Create(ctx context.Context, body *AnalyticsEvent) (*AnalyticsEventCreateResponse, error)
+}
+
+// PresetsAPI is the interface implemented by PresetsService.
+type PresetsAPI interface {
+	List(ctx context.Context) (*PresetListResponse, error)
+	Upsert(ctx context.Context, presetName string, body *PresetUpsertSchema) (*Preset, error)
+	Get(ctx context.Context, presetName string) (*Preset, error)
+	Delete(ctx context.Context, presetName string) (*Preset, error)
+}
+
+// SynonymsAPI is the interface implemented by SynonymsService.
+type SynonymsAPI interface {
+	List(ctx context.Context, collectionName string) (*SearchSynonymsResponse, error)
+	Get(ctx context.Context, collectionName, synonymId string) (*SearchSynonym, error)
+	Upsert(ctx context.Context, collectionName, synonymId string, body *SearchSynonymSchema) (*SearchSynonym, error)
+	Delete(ctx context.Context, collectionName, synonymId string) (*DeleteSynonymResponse, error)
+}
+
+// StopwordsAPI is the interface implemented by StopwordsService.
+type StopwordsAPI interface {
+	List(ctx context.Context) (*StopwordsSetsResponse, error)
+	Get(ctx context.Context, stopwordsSetId string) (*StopwordsSet, error)
+	Upsert(ctx context.Context, stopwordsSetId string, body *StopwordsSetSchema) (*StopwordsSet, error)
+	Delete(ctx context.Context, stopwordsSetId string) (*DeleteStopwordsSetResponse, error)
+}
+
+// ConversationsAPI is the interface implemented by ConversationsService.
+type ConversationsAPI interface {
+	ListModels(ctx context.Context) ([]*ConversationModel, error)
+	CreateModel(ctx context.Context, body *ConversationModelSchema) (*ConversationModel, error)
+	GetModel(ctx context.Context, modelId string) (*ConversationModel, error)
+	UpdateModel(ctx context.Context, modelId string, body *ConversationModelSchema) (*ConversationModel, error)
+	DeleteModel(ctx context.Context, modelId string) (*ConversationModel, error)
+	List(ctx context.Context) (*ConversationsResponse, error)
+	Get(ctx context.Context, conversationId string) (*Conversation, error)
+	Update(ctx context.Context, conversationId string, body *ConversationUpdateSchema) (*Conversation, error)
+	Delete(ctx context.Context, conversationId string) (*Conversation, error)
+}
+
+var (
+	_ ClientAPI          = (*Client)(nil)
+	_ CollectionsAPI     = (*CollectionsService)(nil)
+	_ DocumentsAPI       = (*DocumentsService)(nil)
+	_ KeysAPI            = (*KeysService)(nil)
+	_ RateLimitsAPI      = (*RateLimitsService)(nil)
+	_ OperationsAPI      = (*OperationsService)(nil)
+	_ MetaAPI            = (*MetaService)(nil)
+	_ OverridesAPI       = (*OverridesService)(nil)
+	_ AliasesAPI         = (*AliasesService)(nil)
+	_ AnalyticsRulesAPI  = (*AnalyticsRulesService)(nil)
+	_ AnalyticsEventsAPI = (*AnalyticsEventsService)(nil)
+	_ PresetsAPI         = (*PresetsService)(nil)
+	_ SynonymsAPI        = (*SynonymsService)(nil)
+	_ StopwordsAPI       = (*StopwordsService)(nil)
+	_ ConversationsAPI   = (*ConversationsService)(nil)
+)
+
+func (c *Client) CollectionsAPI() CollectionsAPI         { return c.Collections }
+func (c *Client) DocumentsAPI() DocumentsAPI             { return c.Documents }
+func (c *Client) KeysAPI() KeysAPI                       { return c.Keys }
+func (c *Client) RateLimitsAPI() RateLimitsAPI           { return c.RateLimits }
+func (c *Client) OperationsAPI() OperationsAPI           { return c.Operations }
+func (c *Client) MetaAPI() MetaAPI                       { return c.Meta }
+func (c *Client) OverridesAPI() OverridesAPI             { return c.Overrides }
+func (c *Client) AliasesAPI() AliasesAPI                 { return c.Aliases }
+func (c *Client) AnalyticsRulesAPI() AnalyticsRulesAPI   { return c.AnalyticsRules }
+func (c *Client) AnalyticsEventsAPI() AnalyticsEventsAPI { return c.AnalyticsEvents }
+func (c *Client) PresetsAPI() PresetsAPI                 { return c.Presets }
+func (c *Client) SynonymsAPI() SynonymsAPI               { return c.Synonyms }
+func (c *Client) StopwordsAPI() StopwordsAPI             { return c.Stopwords }
+func (c *Client) ConversationsAPI() ConversationsAPI     { return c.Conversations }
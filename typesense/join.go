@@ -0,0 +1,104 @@
+package typesense
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// JoinStrategy controls how the fields of joined documents are added to the
+// hits, see Include.
+type JoinStrategy string
+
+const (
+	// JoinNest adds the joined document as an object under the collection
+	// name. It is the server default.
+	JoinNest JoinStrategy = "nest"
+	// JoinNestArray is like JoinNest but always adds an array, even for a
+	// single joined document.
+	JoinNestArray JoinStrategy = "nest_array"
+	// JoinMerge merges the joined fields into the hit's document.
+	JoinMerge JoinStrategy = "merge"
+)
+
+// JoinFilter returns a filter_by clause matching documents whose referenced
+// documents in collection match filter. For example
+//
+//	JoinFilter("authors", "country:=US")
+//
+// returns `$authors(country:=US)`. Clauses can be combined with && as usual.
+func JoinFilter(collection, filter string) string {
+	return "$" + collection + "(" + filter + ")"
+}
+
+// Include is an include_fields entry adding fields of documents joined from a
+// referenced collection to the hits.
+type Include struct {
+	// Collection is the referenced collection.
+	Collection string
+
+	// Fields are the fields of the referenced collection to include. If
+	// empty, all fields are included. A field can itself be a nested Include
+	// of a collection referenced by Collection.
+	Fields []string
+
+	// Strategy controls how the joined fields are added. If empty, the server
+	// default JoinNest is used.
+	Strategy JoinStrategy
+
+	// As renames the key the joined documents are nested under.
+	As string
+}
+
+// String returns the include_fields entry, e.g.
+// `$authors(first_name,last_name, strategy: nest) as author`.
+func (in *Include) String() string {
+	fields := "*"
+	if len(in.Fields) > 0 {
+		fields = strings.Join(in.Fields, ",")
+	}
+	s := "$" + in.Collection + "(" + fields
+	if in.Strategy != "" {
+		s += ", strategy: " + string(in.Strategy)
+	}
+	s += ")"
+	if in.As != "" {
+		s += " as " + in.As
+	}
+	return s
+}
+
+// IncludeFields returns an include_fields value listing fields of the
+// searched collection followed by joined collections.
+func IncludeFields(fields []string, joins ...*Include) string {
+	res := append([]string(nil), fields...)
+	for _, in := range joins {
+		res = append(res, in.String())
+	}
+	return strings.Join(res, ",")
+}
+
+// Decode decodes the hit's document, including merged joined fields, into v.
+func (h *SearchResultHit) Decode(v interface{}) error {
+	return remarshal(h.Document, v)
+}
+
+// DecodeJoined decodes the documents joined from the collection, or the name
+// given with Include.As, into v. v is a pointer to a struct for a single
+// referenced document, or to a slice for one-to-many references and the
+// JoinNestArray strategy.
+func (h *SearchResultHit) DecodeJoined(name string, v interface{}) error {
+	joined, ok := h.Document[name]
+	if !ok {
+		return fmt.Errorf("typesense: hit has no documents joined as %q", name)
+	}
+	return remarshal(joined, v)
+}
+
+func remarshal(src, dst interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
@@ -0,0 +1,93 @@
+package typesense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinFilter(t *testing.T) {
+	assert.Equal(t, "$authors(country:=US)", JoinFilter("authors", "country:=US"))
+	assert.Equal(t, "$authors(id:=[a1,a2]) && year:>2000",
+		JoinFilter("authors", "id:=[a1,a2]")+" && year:>2000")
+}
+
+func TestIncludeFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []string
+		include *Include
+		want    string
+	}{
+		{
+			name:    "all fields",
+			include: &Include{Collection: "authors"},
+			want:    "$authors(*)",
+		},
+		{
+			name:    "fields and strategy",
+			fields:  []string{"title"},
+			include: &Include{Collection: "authors", Fields: []string{"first_name", "last_name"}, Strategy: JoinMerge},
+			want:    "title,$authors(first_name,last_name, strategy: merge)",
+		},
+		{
+			name: "nested and renamed",
+			include: &Include{
+				Collection: "authors",
+				Fields:     []string{"name", (&Include{Collection: "publishers", Fields: []string{"name"}}).String()},
+				As:         "author",
+			},
+			want: "$authors(name,$publishers(name)) as author",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IncludeFields(tt.fields, tt.include))
+		})
+	}
+}
+
+func TestSearchResultHit_DecodeJoined(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/collections/books/documents/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "$authors(country:=US)", r.URL.Query().Get("filter_by"))
+		assert.Equal(t, "title,$authors(first_name, strategy: nest)", r.URL.Query().Get("include_fields"))
+		fmt.Fprint(w, `{
+			"found": 1,
+			"hits": [{
+				"document": {
+					"id": "b1",
+					"title": "The Old Man and the Sea",
+					"authors": {"first_name": "Ernest"}
+				}
+			}]
+		}`)
+	})
+
+	res, err := client.Documents.Search(context.Background(), "books", &SearchParameters{
+		Q:             "*",
+		FilterBy:      String(JoinFilter("authors", "country:=US")),
+		IncludeFields: String(IncludeFields([]string{"title"}, &Include{Collection: "authors", Fields: []string{"first_name"}, Strategy: JoinNest})),
+	})
+	require.NoError(t, err)
+
+	var book struct {
+		Title string `json:"title"`
+	}
+	require.NoError(t, res.Hits[0].Decode(&book))
+	assert.Equal(t, "The Old Man and the Sea", book.Title)
+
+	var author struct {
+		FirstName string `json:"first_name"`
+	}
+	require.NoError(t, res.Hits[0].DecodeJoined("authors", &author))
+	assert.Equal(t, "Ernest", author.FirstName)
+
+	assert.Error(t, res.Hits[0].DecodeJoined("publishers", &author))
+}
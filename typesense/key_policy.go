@@ -0,0 +1,336 @@
+package typesense
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// KeyAction is an action an API key permits.
+type KeyAction string
+
+const (
+	// KeyActionAll permits every action, including managing keys. Only admin
+	// keys should have it.
+	KeyActionAll KeyAction = "*"
+
+	KeyActionCollectionsCreate KeyAction = "collections:create"
+	KeyActionCollectionsDelete KeyAction = "collections:delete"
+	KeyActionCollectionsGet    KeyAction = "collections:get"
+	KeyActionCollectionsList   KeyAction = "collections:list"
+	KeyActionCollectionsAll    KeyAction = "collections:*"
+
+	KeyActionDocumentsSearch KeyAction = "documents:search"
+	KeyActionDocumentsGet    KeyAction = "documents:get"
+	KeyActionDocumentsCreate KeyAction = "documents:create"
+	KeyActionDocumentsUpsert KeyAction = "documents:upsert"
+	KeyActionDocumentsUpdate KeyAction = "documents:update"
+	KeyActionDocumentsDelete KeyAction = "documents:delete"
+	KeyActionDocumentsImport KeyAction = "documents:import"
+	KeyActionDocumentsExport KeyAction = "documents:export"
+	KeyActionDocumentsAll    KeyAction = "documents:*"
+
+	KeyActionAliasesList   KeyAction = "aliases:list"
+	KeyActionAliasesGet    KeyAction = "aliases:get"
+	KeyActionAliasesCreate KeyAction = "aliases:create"
+	KeyActionAliasesDelete KeyAction = "aliases:delete"
+	KeyActionAliasesAll    KeyAction = "aliases:*"
+
+	KeyActionSynonymsList   KeyAction = "synonyms:list"
+	KeyActionSynonymsGet    KeyAction = "synonyms:get"
+	KeyActionSynonymsCreate KeyAction = "synonyms:create"
+	KeyActionSynonymsDelete KeyAction = "synonyms:delete"
+	KeyActionSynonymsAll    KeyAction = "synonyms:*"
+
+	KeyActionOverridesList   KeyAction = "overrides:list"
+	KeyActionOverridesGet    KeyAction = "overrides:get"
+	KeyActionOverridesCreate KeyAction = "overrides:create"
+	KeyActionOverridesDelete KeyAction = "overrides:delete"
+	KeyActionOverridesAll    KeyAction = "overrides:*"
+
+	KeyActionStopwordsList   KeyAction = "stopwords:list"
+	KeyActionStopwordsGet    KeyAction = "stopwords:get"
+	KeyActionStopwordsCreate KeyAction = "stopwords:create"
+	KeyActionStopwordsDelete KeyAction = "stopwords:delete"
+	KeyActionStopwordsAll    KeyAction = "stopwords:*"
+
+	KeyActionPresetsList   KeyAction = "presets:list"
+	KeyActionPresetsGet    KeyAction = "presets:get"
+	KeyActionPresetsUpsert KeyAction = "presets:upsert"
+	KeyActionPresetsDelete KeyAction = "presets:delete"
+	KeyActionPresetsAll    KeyAction = "presets:*"
+
+	KeyActionKeysList   KeyAction = "keys:list"
+	KeyActionKeysGet    KeyAction = "keys:get"
+	KeyActionKeysCreate KeyAction = "keys:create"
+	KeyActionKeysDelete KeyAction = "keys:delete"
+	KeyActionKeysAll    KeyAction = "keys:*"
+
+	KeyActionAnalyticsRulesList   KeyAction = "analytics/rules:list"
+	KeyActionAnalyticsRulesGet    KeyAction = "analytics/rules:get"
+	KeyActionAnalyticsRulesCreate KeyAction = "analytics/rules:create"
+	KeyActionAnalyticsRulesDelete KeyAction = "analytics/rules:delete"
+	KeyActionAnalyticsRulesAll    KeyAction = "analytics/rules:*"
+	KeyActionAnalyticsEvents      KeyAction = "analytics/events:create"
+	KeyActionAnalyticsAll         KeyAction = "analytics:*"
+
+	KeyActionMetrics KeyAction = "metrics.json:list"
+	KeyActionStats   KeyAction = "stats.json:list"
+	KeyActionDebug   KeyAction = "debug:list"
+)
+
+var keyActions = map[KeyAction]bool{
+	KeyActionAll: true,
+
+	KeyActionCollectionsCreate: true, KeyActionCollectionsDelete: true,
+	KeyActionCollectionsGet: true, KeyActionCollectionsList: true, KeyActionCollectionsAll: true,
+
+	KeyActionDocumentsSearch: true, KeyActionDocumentsGet: true,
+	KeyActionDocumentsCreate: true, KeyActionDocumentsUpsert: true,
+	KeyActionDocumentsUpdate: true, KeyActionDocumentsDelete: true,
+	KeyActionDocumentsImport: true, KeyActionDocumentsExport: true, KeyActionDocumentsAll: true,
+
+	KeyActionAliasesList: true, KeyActionAliasesGet: true,
+	KeyActionAliasesCreate: true, KeyActionAliasesDelete: true, KeyActionAliasesAll: true,
+
+	KeyActionSynonymsList: true, KeyActionSynonymsGet: true,
+	KeyActionSynonymsCreate: true, KeyActionSynonymsDelete: true, KeyActionSynonymsAll: true,
+
+	KeyActionOverridesList: true, KeyActionOverridesGet: true,
+	KeyActionOverridesCreate: true, KeyActionOverridesDelete: true, KeyActionOverridesAll: true,
+
+	KeyActionStopwordsList: true, KeyActionStopwordsGet: true,
+	KeyActionStopwordsCreate: true, KeyActionStopwordsDelete: true, KeyActionStopwordsAll: true,
+
+	KeyActionPresetsList: true, KeyActionPresetsGet: true,
+	KeyActionPresetsUpsert: true, KeyActionPresetsDelete: true, KeyActionPresetsAll: true,
+
+	KeyActionKeysList: true, KeyActionKeysGet: true,
+	KeyActionKeysCreate: true, KeyActionKeysDelete: true, KeyActionKeysAll: true,
+
+	KeyActionAnalyticsRulesList: true, KeyActionAnalyticsRulesGet: true,
+	KeyActionAnalyticsRulesCreate: true, KeyActionAnalyticsRulesDelete: true,
+	KeyActionAnalyticsRulesAll: true, KeyActionAnalyticsEvents: true, KeyActionAnalyticsAll: true,
+
+	KeyActionMetrics: true, KeyActionStats: true, KeyActionDebug: true,
+}
+
+// Valid reports whether a is an action known to Typesense.
+func (a KeyAction) Valid() bool {
+	return keyActions[a]
+}
+
+// IsWildcard reports whether a permits all actions, or all actions on a
+// resource, such as documents:*.
+func (a KeyAction) IsWildcard() bool {
+	return a == KeyActionAll || strings.HasSuffix(string(a), ":*")
+}
+
+// IsReadOnly reports whether a only reads data.
+func (a KeyAction) IsReadOnly() bool {
+	switch {
+	case a == KeyActionDocumentsSearch, a == KeyActionDocumentsExport:
+		return true
+	case a.IsWildcard():
+		return false
+	}
+	return strings.HasSuffix(string(a), ":get") || strings.HasSuffix(string(a), ":list")
+}
+
+// KeyPolicy builds the ApiKeySchema of a key permitting a set of actions on a
+// set of collections, checking both before the key is created.
+//
+//	schema, err := typesense.NewKeyPolicy("storefront search").
+//		Allow(typesense.KeyActionDocumentsSearch).
+//		OnCollections("products").
+//		OnPrefix("catalog_").
+//		ExpiresIn(30 * 24 * time.Hour).
+//		Build()
+type KeyPolicy struct {
+	description string
+	actions     []KeyAction
+	collections []string
+	expiresAt   *time.Time
+	now         func() time.Time
+}
+
+// NewKeyPolicy returns an empty KeyPolicy for a key with the description.
+func NewKeyPolicy(description string) *KeyPolicy {
+	return &KeyPolicy{description: description, now: time.Now}
+}
+
+// Allow adds actions to the policy.
+func (p *KeyPolicy) Allow(actions ...KeyAction) *KeyPolicy {
+	p.actions = append(p.actions, actions...)
+	return p
+}
+
+// OnCollections adds collections to the policy. Besides names, Typesense
+// accepts regular expressions such as "products_.*" matching collection
+// names, and "*" for all collections.
+func (p *KeyPolicy) OnCollections(collections ...string) *KeyPolicy {
+	p.collections = append(p.collections, collections...)
+	return p
+}
+
+// OnPrefix adds the collections whose name starts with prefix to the policy.
+func (p *KeyPolicy) OnPrefix(prefix string) *KeyPolicy {
+	return p.OnCollections(regexp.QuoteMeta(prefix) + ".*")
+}
+
+// ExpiresAt makes the key expire at t.
+func (p *KeyPolicy) ExpiresAt(t time.Time) *KeyPolicy {
+	p.expiresAt = &t
+	return p
+}
+
+// ExpiresIn makes the key expire d from now.
+func (p *KeyPolicy) ExpiresIn(d time.Duration) *KeyPolicy {
+	return p.ExpiresAt(p.now().Add(d))
+}
+
+// Build returns the schema of the key, or ValidationErrors if an action is
+// unknown, a collection pattern is not a valid regular expression, the
+// expiry is in the past or the policy permits no action or collection.
+func (p *KeyPolicy) Build() (*ApiKeySchema, error) {
+	var errs ValidationErrors
+	if len(p.actions) == 0 {
+		errs.add("actions", "must not be empty")
+	}
+	for i, a := range p.actions {
+		if !a.Valid() {
+			errs.add(fmt.Sprintf("actions[%d]", i), "unknown action %q", a)
+		}
+	}
+	if len(p.collections) == 0 {
+		errs.add("collections", "must not be empty")
+	}
+	for i, c := range p.collections {
+		if c == "*" {
+			continue
+		}
+		if c == "" {
+			errs.add(fmt.Sprintf("collections[%d]", i), "must not be empty")
+		} else if _, err := regexp.Compile(c); err != nil {
+			errs.add(fmt.Sprintf("collections[%d]", i), "invalid pattern %q", c)
+		}
+	}
+	if p.expiresAt != nil && !p.expiresAt.After(p.now()) {
+		errs.add("expires_at", "must be in the future")
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+
+	schema := &ApiKeySchema{
+		Actions:     p.actions,
+		Collections: p.collections,
+		Description: String(p.description),
+	}
+	if p.expiresAt != nil {
+		expiresAt := p.expiresAt.Unix()
+		schema.ExpiresAt = &expiresAt
+	}
+	return schema, nil
+}
+
+// KeyRisk is the severity of a KeyIssue.
+type KeyRisk int
+
+const (
+	KeyRiskLow KeyRisk = iota
+	KeyRiskMedium
+	KeyRiskHigh
+)
+
+func (r KeyRisk) String() string {
+	switch r {
+	case KeyRiskLow:
+		return "low"
+	case KeyRiskMedium:
+		return "medium"
+	case KeyRiskHigh:
+		return "high"
+	}
+	return fmt.Sprintf("KeyRisk(%d)", int(r))
+}
+
+// MarshalText encodes the risk as its name.
+func (r KeyRisk) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// KeyIssue is a risk found in an API key by LintKeys.
+type KeyIssue struct {
+	Key     *ApiKey
+	Risk    KeyRisk
+	Message string
+}
+
+func (i *KeyIssue) String() string {
+	id := "?"
+	if i.Key.Id != nil {
+		id = fmt.Sprint(*i.Key.Id)
+	}
+	return fmt.Sprintf("key %s (%s): %s: %s", id, i.Key.Description, i.Risk, i.Message)
+}
+
+// LintKeys flags risky keys, such as those returned by KeysService.List: keys
+// with all permissions or permission to manage keys, write access to all
+// collections, unknown actions, no expiry or no description, and expired
+// keys that can be deleted. The issues are sorted by descending risk.
+func LintKeys(keys []*ApiKey, now time.Time) []*KeyIssue {
+	var issues []*KeyIssue
+	for _, k := range keys {
+		flag := func(risk KeyRisk, format string, args ...interface{}) {
+			issues = append(issues, &KeyIssue{Key: k, Risk: risk, Message: fmt.Sprintf(format, args...)})
+		}
+
+		allCollections := false
+		for _, c := range k.Collections {
+			if c == "*" || c == ".*" {
+				allCollections = true
+			}
+		}
+		readOnly := true
+		for _, a := range k.Actions {
+			switch {
+			case !a.Valid():
+				flag(KeyRiskMedium, "unknown action %q", a)
+			case a == KeyActionAll:
+				flag(KeyRiskHigh, "permits every action; use an admin key only where needed")
+			case a == KeyActionKeysAll || a == KeyActionKeysCreate:
+				flag(KeyRiskHigh, "can create keys with any permissions")
+			}
+			if !a.IsReadOnly() {
+				readOnly = false
+			}
+		}
+		if !readOnly && allCollections && !slices.Contains(k.Actions, KeyActionAll) {
+			flag(KeyRiskMedium, "can write to all collections")
+		}
+
+		switch {
+		case k.ExpiresAt == nil || *k.ExpiresAt <= 0 || *k.ExpiresAt >= neverExpires:
+			if !readOnly {
+				flag(KeyRiskLow, "never expires")
+			}
+		case time.Unix(*k.ExpiresAt, 0).Before(now):
+			flag(KeyRiskLow, "expired at %s and can be deleted", time.Unix(*k.ExpiresAt, 0).UTC().Format(time.RFC3339))
+		}
+		if strings.TrimSpace(k.Description) == "" {
+			flag(KeyRiskLow, "has no description")
+		}
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Risk > issues[j].Risk
+	})
+	return issues
+}
+
+// neverExpires is the expires_at the server reports for keys created without
+// one.
+const neverExpires = 64723363199
@@ -0,0 +1,105 @@
+package typesense
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyAction(t *testing.T) {
+	assert.True(t, KeyActionDocumentsSearch.Valid())
+	assert.True(t, KeyActionAll.Valid())
+	assert.False(t, KeyAction("documents:find").Valid())
+
+	assert.True(t, KeyActionDocumentsAll.IsWildcard())
+	assert.False(t, KeyActionDocumentsSearch.IsWildcard())
+
+	assert.True(t, KeyActionDocumentsSearch.IsReadOnly())
+	assert.True(t, KeyActionCollectionsList.IsReadOnly())
+	assert.False(t, KeyActionDocumentsImport.IsReadOnly())
+	assert.False(t, KeyActionSynonymsAll.IsReadOnly())
+	assert.False(t, KeyActionAnalyticsEvents.IsReadOnly())
+}
+
+func TestKeyPolicy_Build(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	p := NewKeyPolicy("storefront search")
+	p.now = func() time.Time { return now }
+
+	got, err := p.Allow(KeyActionDocumentsSearch, KeyActionDocumentsGet).
+		OnCollections("products").
+		OnPrefix("catalog_v1.").
+		ExpiresIn(24 * time.Hour).
+		Build()
+	require.NoError(t, err)
+
+	expiresAt := now.Add(24 * time.Hour).Unix()
+	want := &ApiKeySchema{
+		Actions:     []KeyAction{KeyActionDocumentsSearch, KeyActionDocumentsGet},
+		Collections: []string{"products", `catalog_v1\..*`},
+		Description: String("storefront search"),
+		ExpiresAt:   &expiresAt,
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestKeyPolicy_BuildInvalid(t *testing.T) {
+	_, err := NewKeyPolicy("broken").
+		Allow(KeyActionDocumentsSearch, "documents:find").
+		OnCollections("products", "orders_(").
+		ExpiresAt(time.Now().Add(-time.Hour)).
+		Build()
+
+	var errs ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	assert.Equal(t, `actions[1]: unknown action "documents:find"; `+
+		`collections[1]: invalid pattern "orders_("; `+
+		`expires_at: must be in the future`, errs.Error())
+
+	_, err = NewKeyPolicy("empty").Build()
+	assert.EqualError(t, err, "actions: must not be empty; collections: must not be empty")
+}
+
+func TestLintKeys(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	id := func(i int64) *int64 { return &i }
+	expired := now.Add(-time.Hour).Unix()
+	future := now.Add(time.Hour).Unix()
+	never := int64(neverExpires)
+
+	keys := []*ApiKey{
+		{Id: id(1), Description: "admin", Actions: []KeyAction{KeyActionAll}, Collections: []string{"*"}, ExpiresAt: &never},
+		{Id: id(2), Description: "search", Actions: []KeyAction{KeyActionDocumentsSearch}, Collections: []string{"*"}},
+		{Id: id(3), Description: "key manager", Actions: []KeyAction{KeyActionKeysCreate}, Collections: []string{"*"}, ExpiresAt: &future},
+		{Id: id(4), Description: "indexer", Actions: []KeyAction{KeyActionDocumentsAll}, Collections: []string{"products"}, ExpiresAt: &future},
+		{Id: id(5), Description: "old import", Actions: []KeyAction{KeyActionDocumentsImport}, Collections: []string{"products"}, ExpiresAt: &expired},
+		{Id: id(6), Actions: []KeyAction{"documents:find"}, Collections: []string{".*"}, ExpiresAt: &future},
+		{Id: id(7), Description: "storefront", Actions: []KeyAction{KeyActionDocumentsSearch, KeyActionAnalyticsEvents}, Collections: []string{"*"}},
+	}
+
+	var got []string
+	for _, issue := range LintKeys(keys, now) {
+		got = append(got, issue.String())
+	}
+	assert.Equal(t, []string{
+		"key 1 (admin): high: permits every action; use an admin key only where needed",
+		"key 3 (key manager): high: can create keys with any permissions",
+		"key 3 (key manager): medium: can write to all collections",
+		"key 6 (): medium: unknown action \"documents:find\"",
+		"key 6 (): medium: can write to all collections",
+		"key 7 (storefront): medium: can write to all collections",
+		"key 1 (admin): low: never expires",
+		"key 5 (old import): low: expired at 2024-03-01T11:00:00Z and can be deleted",
+		"key 6 (): low: has no description",
+		"key 7 (storefront): low: never expires",
+	}, got)
+}
+
+func TestKeyRisk_MarshalText(t *testing.T) {
+	b, err := json.Marshal(&KeyIssue{Risk: KeyRiskHigh})
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"Risk":"high"`)
+}
@@ -0,0 +1,216 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRotationInterval = 30 * 24 * time.Hour
+	defaultGracePeriod      = time.Hour
+)
+
+// KeyRotator rotates an API key: it creates a replacement with the same
+// actions, collections, description and expiry, hands it to OnRotate for
+// distribution, swaps it into Clients and deletes the replaced key once
+// GracePeriod has passed, so that every holder of the old key has time to
+// switch.
+//
+// The keys of a rotator are tracked by a tag in their description: the key
+// with the highest id whose description contains Tag is the current one, all
+// other keys with the tag are replaced.
+type KeyRotator struct {
+	keys KeysAPI
+
+	// Tag identifies the keys managed by the rotator, e.g. "[rotate:search]".
+	Tag string
+
+	// Clients are switched to the new key on each rotation with SetAPIKey,
+	// which replaces their CredentialProvider.
+	Clients []*Client
+
+	// OnRotate, if set, is called with the new key, whose Value is set,
+	// before it is swapped into Clients. If it returns an error, the new
+	// key is deleted and the rotation fails.
+	OnRotate func(ctx context.Context, key *ApiKey) error
+
+	// GracePeriod is the time a replaced key remains valid.
+	// Default: 1h
+	GracePeriod time.Duration
+
+	// Interval is the time between two rotations by Run.
+	// Default: 720h
+	Interval time.Duration
+
+	// ExpiresIn, if set, makes each new key expire ExpiresIn after its
+	// rotation. Otherwise the new key expires when the current one does.
+	ExpiresIn time.Duration
+
+	// OnError, if set, is called with the errors of Run.
+	OnError func(error)
+
+	mu       sync.Mutex
+	replaced map[int64]time.Time
+	now      func() time.Time
+}
+
+// NewKeyRotator returns a KeyRotator managing the keys tagged with tag through
+// keys, which needs permission to create and delete keys.
+func NewKeyRotator(keys KeysAPI, tag string) *KeyRotator {
+	return &KeyRotator{
+		keys:        keys,
+		Tag:         tag,
+		GracePeriod: defaultGracePeriod,
+		Interval:    defaultRotationInterval,
+		replaced:    make(map[int64]time.Time),
+		now:         time.Now,
+	}
+}
+
+// Run rotates the key every Interval and deletes the replaced keys after their
+// grace period until ctx is done. An Interval <= 0 is replaced by the default.
+func (r *KeyRotator) Run(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = defaultRotationInterval
+	}
+	check := r.GracePeriod / 4
+	if check <= 0 || check > interval {
+		check = interval
+	}
+	prune := time.NewTicker(check)
+	defer prune.Stop()
+	rotate := time.NewTicker(interval)
+	defer rotate.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-rotate.C:
+			_, err = r.Rotate(ctx)
+		case <-prune.C:
+			_, err = r.Prune(ctx)
+		}
+		if err != nil && r.OnError != nil {
+			r.OnError(err)
+		}
+	}
+}
+
+// Current returns the current key. Its Value is not set; the server only
+// returns the value when a key is created.
+func (r *KeyRotator) Current(ctx context.Context) (*ApiKey, error) {
+	current, _, err := r.list(ctx)
+	return current, err
+}
+
+// list returns the current key and the keys it replaced.
+func (r *KeyRotator) list(ctx context.Context) (*ApiKey, []*ApiKey, error) {
+	if r.Tag == "" {
+		return nil, nil, errors.New("typesense: key rotator without tag")
+	}
+	res, err := r.keys.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var current *ApiKey
+	var replaced []*ApiKey
+	for _, k := range res.Keys {
+		if k.Id == nil || !strings.Contains(k.Description, r.Tag) {
+			continue
+		}
+		if current == nil || *k.Id > *current.Id {
+			if current != nil {
+				replaced = append(replaced, current)
+			}
+			current = k
+		} else {
+			replaced = append(replaced, k)
+		}
+	}
+	if current == nil {
+		return nil, nil, fmt.Errorf("typesense: no key tagged %q", r.Tag)
+	}
+	return current, replaced, nil
+}
+
+// Rotate creates a replacement of the current key, distributes it and swaps
+// it into Clients. The replaced key is deleted by Prune after GracePeriod.
+func (r *KeyRotator) Rotate(ctx context.Context) (*ApiKey, error) {
+	current, _, err := r.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	description := current.Description
+	expiresAt := current.ExpiresAt
+	if r.ExpiresIn > 0 {
+		expiresAt = Int64(int(r.now().Add(r.ExpiresIn).Unix()))
+	}
+	key, err := r.keys.Create(ctx, &ApiKeySchema{
+		Actions:     current.Actions,
+		Collections: current.Collections,
+		Description: &description,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create key: %w", err)
+	}
+	if key.Id == nil || key.Value == nil {
+		return nil, errors.New("typesense: created key without id or value")
+	}
+
+	if r.OnRotate != nil {
+		if err := r.OnRotate(ctx, key); err != nil {
+			if _, derr := r.keys.Delete(ctx, int(*key.Id)); derr != nil {
+				return nil, fmt.Errorf("distribute key %d: %w (deleting it: %v)", *key.Id, err, derr)
+			}
+			return nil, fmt.Errorf("distribute key %d: %w", *key.Id, err)
+		}
+	}
+	for _, c := range r.Clients {
+		c.SetAPIKey(*key.Value)
+	}
+
+	r.mu.Lock()
+	r.replaced[*current.Id] = r.now()
+	r.mu.Unlock()
+	return key, nil
+}
+
+// Prune deletes the replaced keys whose grace period has passed and returns
+// their ids. Replaced keys the rotator has not seen being replaced, e.g.
+// after a restart, get their grace period from the first call of Prune.
+func (r *KeyRotator) Prune(ctx context.Context) ([]int64, error) {
+	_, replaced, err := r.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	var deleted []int64
+	for _, k := range replaced {
+		replacedAt, ok := r.replaced[*k.Id]
+		if !ok {
+			r.replaced[*k.Id] = now
+			continue
+		}
+		if now.Sub(replacedAt) < r.GracePeriod {
+			continue
+		}
+		if _, err := r.keys.Delete(ctx, int(*k.Id)); err != nil {
+			return deleted, fmt.Errorf("delete key %d: %w", *k.Id, err)
+		}
+		delete(r.replaced, *k.Id)
+		deleted = append(deleted, *k.Id)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,185 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKeys is an in-memory KeysAPI.
+type fakeKeys struct {
+	keys   []*ApiKey
+	nextId int64
+}
+
+func (f *fakeKeys) List(ctx context.Context) (*ApiKeysResponse, error) {
+	res := &ApiKeysResponse{}
+	for _, k := range f.keys {
+		k := *k
+		k.Value = nil
+		res.Keys = append(res.Keys, &k)
+	}
+	return res, nil
+}
+
+func (f *fakeKeys) Create(ctx context.Context, body *ApiKeySchema) (*ApiKey, error) {
+	f.nextId++
+	id, value := f.nextId, fmt.Sprintf("key-%d", f.nextId)
+	k := &ApiKey{Id: &id, Value: &value, Actions: body.Actions, Collections: body.Collections, Description: *body.Description, ExpiresAt: body.ExpiresAt}
+	f.keys = append(f.keys, k)
+	return k, nil
+}
+
+func (f *fakeKeys) Get(ctx context.Context, keyId int) (*ApiKey, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeKeys) Delete(ctx context.Context, keyId int) (*ApiKey, error) {
+	for i, k := range f.keys {
+		if *k.Id == int64(keyId) {
+			f.keys = append(f.keys[:i], f.keys[i+1:]...)
+			return k, nil
+		}
+	}
+	return nil, errors.New("key not found")
+}
+
+func (f *fakeKeys) ids() []int64 {
+	var ids []int64
+	for _, k := range f.keys {
+		ids = append(ids, *k.Id)
+	}
+	return ids
+}
+
+func newFakeKeys(t *testing.T) *fakeKeys {
+	f := &fakeKeys{}
+	ctx := context.Background()
+	_, err := f.Create(ctx, &ApiKeySchema{Actions: []KeyAction{"*"}, Collections: []string{"*"}, Description: String("admin")})
+	require.NoError(t, err)
+	_, err = f.Create(ctx, &ApiKeySchema{
+		Actions:     []KeyAction{"documents:search"},
+		Collections: []string{"companies"},
+		Description: String("storefront search [rotate:search]"),
+		ExpiresAt:   Int64(1893456000),
+	})
+	require.NoError(t, err)
+	return f
+}
+
+func TestKeyRotator_Rotate(t *testing.T) {
+	keys := newFakeKeys(t)
+	client, err := NewClient(nil, "", "key-2")
+	require.NoError(t, err)
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	r := NewKeyRotator(keys, "[rotate:search]")
+	r.Clients = []*Client{client}
+	r.now = func() time.Time { return now }
+	var distributed []string
+	r.OnRotate = func(ctx context.Context, key *ApiKey) error {
+		distributed = append(distributed, *key.Value)
+		return nil
+	}
+	ctx := context.Background()
+
+	key, err := r.Rotate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), *key.Id)
+	assert.Equal(t, []KeyAction{KeyActionDocumentsSearch}, key.Actions)
+	assert.Equal(t, []string{"companies"}, key.Collections)
+	assert.Equal(t, "storefront search [rotate:search]", key.Description)
+	assert.Equal(t, Int64(1893456000), key.ExpiresAt)
+	assert.Equal(t, []string{"key-3"}, distributed)
+	assert.Equal(t, "key-3", client.APIKey())
+
+	current, err := r.Current(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), *current.Id)
+
+	deleted, err := r.Prune(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+	assert.Equal(t, []int64{1, 2, 3}, keys.ids())
+
+	now = now.Add(time.Hour)
+	deleted, err = r.Prune(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, deleted)
+	assert.Equal(t, []int64{1, 3}, keys.ids())
+}
+
+func TestKeyRotator_ExpiresIn(t *testing.T) {
+	keys := newFakeKeys(t)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	r := NewKeyRotator(keys, "[rotate:search]")
+	r.now = func() time.Time { return now }
+	r.ExpiresIn = 60 * 24 * time.Hour
+
+	key, err := r.Rotate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Int64(int(now.Add(r.ExpiresIn).Unix())), key.ExpiresAt)
+}
+
+func TestKeyRotator_RotateDistributionFails(t *testing.T) {
+	keys := newFakeKeys(t)
+	client, err := NewClient(nil, "", "key-2")
+	require.NoError(t, err)
+
+	r := NewKeyRotator(keys, "[rotate:search]")
+	r.Clients = []*Client{client}
+	r.OnRotate = func(ctx context.Context, key *ApiKey) error {
+		return errors.New("vault unavailable")
+	}
+
+	_, err = r.Rotate(context.Background())
+	assert.ErrorContains(t, err, "vault unavailable")
+	assert.Equal(t, "key-2", client.APIKey())
+	assert.Equal(t, []int64{1, 2}, keys.ids())
+}
+
+func TestKeyRotator_PruneAfterRestart(t *testing.T) {
+	keys := newFakeKeys(t)
+	ctx := context.Background()
+	_, err := NewKeyRotator(keys, "[rotate:search]").Rotate(ctx)
+	require.NoError(t, err)
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	r := NewKeyRotator(keys, "[rotate:search]")
+	r.now = func() time.Time { return now }
+
+	deleted, err := r.Prune(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+
+	now = now.Add(59 * time.Minute)
+	deleted, err = r.Prune(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+
+	now = now.Add(time.Minute)
+	deleted, err = r.Prune(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, deleted)
+}
+
+func TestKeyRotator_NoTaggedKey(t *testing.T) {
+	r := NewKeyRotator(newFakeKeys(t), "[rotate:admin]")
+	_, err := r.Rotate(context.Background())
+	assert.ErrorContains(t, err, `no key tagged "[rotate:admin]"`)
+}
+
+func TestKeyRotator_RunInvalidInterval(t *testing.T) {
+	r := NewKeyRotator(newFakeKeys(t), "[rotate:search]")
+	r.Interval = 0
+	r.GracePeriod = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, r.Run(ctx), context.DeadlineExceeded)
+}
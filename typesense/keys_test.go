@@ -42,7 +42,7 @@ func TestKeysService_List(t *testing.T) {
 	want := &ApiKeysResponse{
 		Keys: []*ApiKey{
 			{
-				Actions:     []string{"*"},
+				Actions:     []KeyAction{"*"},
 				Collections: []string{"*"},
 				Description: "Admin key.",
 				ExpiresAt:   Int64(64723363199),
@@ -78,7 +78,7 @@ func TestKeysService_Create(t *testing.T) {
 
 	ctx := context.Background()
 	body := &ApiKeySchema{
-		Actions:     []string{"*"},
+		Actions:     []KeyAction{"*"},
 		Collections: []string{"*"},
 		Description: String("Admin key."),
 		ExpiresAt:   Int64(64723363199),
@@ -88,7 +88,7 @@ func TestKeysService_Create(t *testing.T) {
 	assert.NoError(t, err)
 
 	want := &ApiKey{
-		Actions:     []string{"*"},
+		Actions:     []KeyAction{"*"},
 		Collections: []string{"*"},
 		Description: "Admin key.",
 		ExpiresAt:   Int64(64723363199),
@@ -127,7 +127,7 @@ func TestKeysService_Get(t *testing.T) {
 	assert.NoError(t, err)
 
 	want := &ApiKey{
-		Actions:     []string{"*"},
+		Actions:     []KeyAction{"*"},
 		Collections: []string{"*"},
 		Description: "Admin key.",
 		ExpiresAt:   Int64(64723363199),
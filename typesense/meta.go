@@ -2,6 +2,9 @@ package typesense
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -13,9 +16,9 @@ type HealthStatus struct {
 }
 
 type NodeStatus struct {
-	CommittedIndex int    `json:"committed_index"`
-	QueuedWrites   int    `json:"queued_writes"`
-	State          string `json:"state"`
+	CommittedIndex int       `json:"committed_index"`
+	QueuedWrites   int       `json:"queued_writes"`
+	State          RaftState `json:"state"`
 }
 type Config struct {
 	LogSlowRequestsTimeMS *int  `json:"log-slow-requests-time-ms,omitempty"`
@@ -24,22 +27,62 @@ type Config struct {
 	SkipWrites            *bool `json:"skip-writes,omitempty"`
 }
 
+// Metrics are the system and memory metrics of a node. Byte counts are
+// cumulative since the node started for the network metrics and current
+// values otherwise.
 type Metrics struct {
-	SystemCPUActivePercentage         string            `json:"system_cpu_active_percentage"`
-	SystemCPUIndividualPercentage     map[string]string `json:"-"`
-	SystemDiskTotalBytes              string            `json:"system_disk_total_bytes"`
-	SystemDiskUsedBytes               string            `json:"system_disk_used_bytes"`
-	SystemMemoryTotalBytes            string            `json:"system_memory_total_bytes"`
-	SystemMemoryUsedBytes             string            `json:"system_memory_used_bytes"`
-	SystemNetworkReceivedBytes        string            `json:"system_network_received_bytes"`
-	SystemNetworkSentBytes            string            `json:"system_network_sent_bytes"`
-	TypesenseMemoryActiveBytes        string            `json:"typesense_memory_active_bytes"`
-	TypesenseMemoryAllocatedBytes     string            `json:"typesense_memory_allocated_bytes"`
-	TypesenseMemoryFragmentationRatio string            `json:"typesense_memory_fragmentation_ratio"`
-	TypesenseMemoryMappedBytes        string            `json:"typesense_memory_mapped_bytes"`
-	TypesenseMemoryMetadataBytes      string            `json:"typesense_memory_metadata_bytes"`
-	TypesenseMemoryResidentBytes      string            `json:"typesense_memory_resident_bytes"`
-	TypesenseMemoryRetainedBytes      string            `json:"typesense_memory_retained_bytes"`
+	SystemCPUActivePercentage float64 `json:"system_cpu_active_percentage"`
+
+	// SystemCPUIndividualPercentage are the active percentages of each CPU,
+	// sorted by CPU number.
+	SystemCPUIndividualPercentage     []CPUPercentage `json:"system_cpu_individual_percentage,omitempty"`
+	SystemDiskTotalBytes              uint64          `json:"system_disk_total_bytes"`
+	SystemDiskUsedBytes               uint64          `json:"system_disk_used_bytes"`
+	SystemMemoryTotalBytes            uint64          `json:"system_memory_total_bytes"`
+	SystemMemoryUsedBytes             uint64          `json:"system_memory_used_bytes"`
+	SystemNetworkReceivedBytes        uint64          `json:"system_network_received_bytes"`
+	SystemNetworkSentBytes            uint64          `json:"system_network_sent_bytes"`
+	TypesenseMemoryActiveBytes        uint64          `json:"typesense_memory_active_bytes"`
+	TypesenseMemoryAllocatedBytes     uint64          `json:"typesense_memory_allocated_bytes"`
+	TypesenseMemoryFragmentationRatio float64         `json:"typesense_memory_fragmentation_ratio"`
+	TypesenseMemoryMappedBytes        uint64          `json:"typesense_memory_mapped_bytes"`
+	TypesenseMemoryMetadataBytes      uint64          `json:"typesense_memory_metadata_bytes"`
+	TypesenseMemoryResidentBytes      uint64          `json:"typesense_memory_resident_bytes"`
+	TypesenseMemoryRetainedBytes      uint64          `json:"typesense_memory_retained_bytes"`
+}
+
+// CPUPercentage is the active percentage of one CPU.
+type CPUPercentage struct {
+	CPU        int     `json:"cpu"`
+	Percentage float64 `json:"percentage"`
+}
+
+// MemoryUtilization returns the fraction of the system memory in use,
+// between 0 and 1.
+func (m *Metrics) MemoryUtilization() float64 {
+	return ratio(m.SystemMemoryUsedBytes, m.SystemMemoryTotalBytes)
+}
+
+// DiskUtilization returns the fraction of the disk in use, between 0 and 1.
+func (m *Metrics) DiskUtilization() float64 {
+	return ratio(m.SystemDiskUsedBytes, m.SystemDiskTotalBytes)
+}
+
+// Fragmentation returns the fraction of the memory held by the allocator in
+// active pages that is not allocated to Typesense. It is the unrounded
+// TypesenseMemoryFragmentationRatio.
+func (m *Metrics) Fragmentation() float64 {
+	if m.TypesenseMemoryActiveBytes == 0 {
+		return 0
+	}
+	return 1 - ratio(m.TypesenseMemoryAllocatedBytes, m.TypesenseMemoryActiveBytes)
+}
+
+func ratio(n, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
 }
 
 type Stats struct {
@@ -85,47 +128,91 @@ func (s *MetaService) Metrics(ctx context.Context) (*Metrics, error) {
 	if err != nil {
 		return nil, err
 	}
+	return parseMetrics(res)
+}
+
+func parseMetrics(res map[string]string) (*Metrics, error) {
 	m := &Metrics{}
-	m.SystemCPUIndividualPercentage = make(map[string]string)
+	uints := map[string]*uint64{
+		"system_disk_total_bytes":          &m.SystemDiskTotalBytes,
+		"system_disk_used_bytes":           &m.SystemDiskUsedBytes,
+		"system_memory_total_bytes":        &m.SystemMemoryTotalBytes,
+		"system_memory_used_bytes":         &m.SystemMemoryUsedBytes,
+		"system_network_received_bytes":    &m.SystemNetworkReceivedBytes,
+		"system_network_sent_bytes":        &m.SystemNetworkSentBytes,
+		"typesense_memory_active_bytes":    &m.TypesenseMemoryActiveBytes,
+		"typesense_memory_allocated_bytes": &m.TypesenseMemoryAllocatedBytes,
+		"typesense_memory_mapped_bytes":    &m.TypesenseMemoryMappedBytes,
+		"typesense_memory_metadata_bytes":  &m.TypesenseMemoryMetadataBytes,
+		"typesense_memory_resident_bytes":  &m.TypesenseMemoryResidentBytes,
+		"typesense_memory_retained_bytes":  &m.TypesenseMemoryRetainedBytes,
+	}
+	floats := map[string]*float64{
+		"system_cpu_active_percentage":         &m.SystemCPUActivePercentage,
+		"typesense_memory_fragmentation_ratio": &m.TypesenseMemoryFragmentationRatio,
+	}
+
 	for key, value := range res {
-		switch key {
-		case "system_cpu_active_percentage":
-			m.SystemCPUActivePercentage = value
-		case "system_disk_total_bytes":
-			m.SystemDiskTotalBytes = value
-		case "system_disk_used_bytes":
-			m.SystemDiskUsedBytes = value
-		case "system_memory_total_bytes":
-			m.SystemMemoryTotalBytes = value
-		case "system_memory_used_bytes":
-			m.SystemMemoryUsedBytes = value
-		case "system_network_received_bytes":
-			m.SystemNetworkReceivedBytes = value
-		case "system_network_sent_bytes":
-			m.SystemNetworkSentBytes = value
-		case "typesense_memory_active_bytes":
-			m.TypesenseMemoryActiveBytes = value
-		case "typesense_memory_allocated_bytes":
-			m.TypesenseMemoryAllocatedBytes = value
-		case "typesense_memory_fragmentation_ratio":
-			m.TypesenseMemoryFragmentationRatio = value
-		case "typesense_memory_mapped_bytes":
-			m.TypesenseMemoryMappedBytes = value
-		case "typesense_memory_metadata_bytes":
-			m.TypesenseMemoryMetadataBytes = value
-		case "typesense_memory_resident_bytes":
-			m.TypesenseMemoryResidentBytes = value
-		case "typesense_memory_retained_bytes":
-			m.TypesenseMemoryRetainedBytes = value
-		default:
-			if strings.HasPrefix(key, "system_cpu") && strings.HasSuffix(key, "active_percentage") {
-				m.SystemCPUIndividualPercentage[key] = value
-			}
+		var err error
+		if p, ok := uints[key]; ok {
+			*p, err = strconv.ParseUint(value, 10, 64)
+		} else if p, ok := floats[key]; ok {
+			*p, err = strconv.ParseFloat(value, 64)
+		} else if cpu, ok := cpuNumber(key); ok {
+			var pct float64
+			pct, err = strconv.ParseFloat(value, 64)
+			m.SystemCPUIndividualPercentage = append(m.SystemCPUIndividualPercentage, CPUPercentage{CPU: cpu, Percentage: pct})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("typesense: metric %s: %w", key, err)
 		}
 	}
+	sort.Slice(m.SystemCPUIndividualPercentage, func(i, j int) bool {
+		return m.SystemCPUIndividualPercentage[i].CPU < m.SystemCPUIndividualPercentage[j].CPU
+	})
 	return m, nil
 }
 
+// cpuNumber returns the CPU number n of a `system_cpu<n>_active_percentage`
+// metric.
+func cpuNumber(key string) (int, bool) {
+	if !strings.HasPrefix(key, "system_cpu") || !strings.HasSuffix(key, "_active_percentage") {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(key, "system_cpu"), "_active_percentage"))
+	return n, err == nil
+}
+
+// EndpointLatency is the average latency and request rate of an endpoint.
+type EndpointLatency struct {
+	Endpoint          string
+	LatencyMS         float32
+	RequestsPerSecond float32
+}
+
+// TopLatencies returns the n endpoints of LatencyMS with the highest latency,
+// slowest first, or all of them if n <= 0.
+func (s *Stats) TopLatencies(n int) []EndpointLatency {
+	res := make([]EndpointLatency, 0, len(s.LatencyMS))
+	for endpoint, latency := range s.LatencyMS {
+		res = append(res, EndpointLatency{
+			Endpoint:          endpoint,
+			LatencyMS:         latency,
+			RequestsPerSecond: s.RequestsPerSecond[endpoint],
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].LatencyMS != res[j].LatencyMS {
+			return res[i].LatencyMS > res[j].LatencyMS
+		}
+		return res[i].Endpoint < res[j].Endpoint
+	})
+	if n > 0 && n < len(res) {
+		res = res[:n]
+	}
+	return res
+}
+
 func (s *MetaService) Stats(ctx context.Context) (*Stats, error) {
 	u := "/stats.json"
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -142,8 +229,8 @@ func (s *MetaService) Stats(ctx context.Context) (*Stats, error) {
 }
 
 type Debug struct {
-	State   int    `json:"state"`
-	Version string `json:"version"`
+	State   RaftState `json:"state"`
+	Version string    `json:"version"`
 }
 
 func (s *MetaService) Debug(ctx context.Context) (*Debug, error) {
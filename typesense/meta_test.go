@@ -82,37 +82,52 @@ func TestMetaService_Metrics(t *testing.T) {
 	got, err := client.Meta.Metrics(ctx)
 	assert.NoError(t, err)
 	want := &Metrics{
-		SystemCPUActivePercentage: "9.24",
-		SystemCPUIndividualPercentage: map[string]string{
-			"system_cpu10_active_percentage": "0.00",
-			"system_cpu11_active_percentage": "0.00",
-			"system_cpu12_active_percentage": "20.00",
-			"system_cpu1_active_percentage":  "30.77",
-			"system_cpu2_active_percentage":  "11.11",
-			"system_cpu3_active_percentage":  "44.44",
-			"system_cpu4_active_percentage":  "0.00",
-			"system_cpu5_active_percentage":  "10.00",
-			"system_cpu6_active_percentage":  "0.00",
-			"system_cpu7_active_percentage":  "0.00",
-			"system_cpu8_active_percentage":  "10.00",
-			"system_cpu9_active_percentage":  "11.11",
+		SystemCPUActivePercentage: 9.24,
+		SystemCPUIndividualPercentage: []CPUPercentage{
+			{CPU: 1, Percentage: 30.77},
+			{CPU: 2, Percentage: 11.11},
+			{CPU: 3, Percentage: 44.44},
+			{CPU: 4, Percentage: 0},
+			{CPU: 5, Percentage: 10},
+			{CPU: 6, Percentage: 0},
+			{CPU: 7, Percentage: 0},
+			{CPU: 8, Percentage: 10},
+			{CPU: 9, Percentage: 11.11},
+			{CPU: 10, Percentage: 0},
+			{CPU: 11, Percentage: 0},
+			{CPU: 12, Percentage: 20},
 		},
-		SystemDiskTotalBytes:              "241369505792",
-		SystemDiskUsedBytes:               "116254326784",
-		SystemMemoryTotalBytes:            "7618924544",
-		SystemMemoryUsedBytes:             "6258020352",
-		SystemNetworkReceivedBytes:        "263031",
-		SystemNetworkSentBytes:            "5210",
-		TypesenseMemoryActiveBytes:        "45043712",
-		TypesenseMemoryAllocatedBytes:     "39953112",
-		TypesenseMemoryFragmentationRatio: "0.11",
-		TypesenseMemoryMappedBytes:        "166768640",
-		TypesenseMemoryMetadataBytes:      "16807696",
-		TypesenseMemoryResidentBytes:      "45043712",
-		TypesenseMemoryRetainedBytes:      "84889600",
+		SystemDiskTotalBytes:              241369505792,
+		SystemDiskUsedBytes:               116254326784,
+		SystemMemoryTotalBytes:            7618924544,
+		SystemMemoryUsedBytes:             6258020352,
+		SystemNetworkReceivedBytes:        263031,
+		SystemNetworkSentBytes:            5210,
+		TypesenseMemoryActiveBytes:        45043712,
+		TypesenseMemoryAllocatedBytes:     39953112,
+		TypesenseMemoryFragmentationRatio: 0.11,
+		TypesenseMemoryMappedBytes:        166768640,
+		TypesenseMemoryMetadataBytes:      16807696,
+		TypesenseMemoryResidentBytes:      45043712,
+		TypesenseMemoryRetainedBytes:      84889600,
 	}
-
 	assert.Equal(t, want, got)
+
+	assert.InDelta(t, 0.8214, got.MemoryUtilization(), 0.0001)
+	assert.InDelta(t, 0.4816, got.DiskUtilization(), 0.0001)
+	assert.InDelta(t, 0.1130, got.Fragmentation(), 0.0001)
+}
+
+func TestMetaService_MetricsInvalid(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/metrics.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"system_memory_used_bytes": "lots"}`)
+	})
+
+	_, err := client.Meta.Metrics(context.Background())
+	assert.ErrorContains(t, err, "metric system_memory_used_bytes")
 }
 
 func TestMetaService_Stats(t *testing.T) {
@@ -170,6 +185,27 @@ func TestMetaService_Stats(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestStats_TopLatencies(t *testing.T) {
+	stats := &Stats{
+		LatencyMS: map[string]float32{
+			"GET /collections/products/documents/search":  12.5,
+			"POST /multi_search":                          30,
+			"GET /health":                                 0.1,
+			"POST /collections/products/documents/import": 12.5,
+		},
+		RequestsPerSecond: map[string]float32{
+			"POST /multi_search": 4,
+		},
+	}
+
+	assert.Equal(t, []EndpointLatency{
+		{Endpoint: "POST /multi_search", LatencyMS: 30, RequestsPerSecond: 4},
+		{Endpoint: "GET /collections/products/documents/search", LatencyMS: 12.5},
+		{Endpoint: "POST /collections/products/documents/import", LatencyMS: 12.5},
+	}, stats.TopLatencies(3))
+	assert.Len(t, stats.TopLatencies(0), 4)
+}
+
 func TestMetaService_Debug(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -190,7 +226,7 @@ func TestMetaService_Debug(t *testing.T) {
 	assert.NoError(t, err)
 
 	want := &Debug{
-		State:   1,
+		State:   RaftLeader,
 		Version: "0.25.0",
 	}
 
@@ -244,7 +280,7 @@ func TestMetaService_Status(t *testing.T) {
 	want := &NodeStatus{
 		CommittedIndex: 250,
 		QueuedWrites:   0,
-		State:          "LEADER",
+		State:          RaftLeader,
 	}
 
 	assert.Equal(t, want, got)
@@ -0,0 +1,1699 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=mocks/mocks.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	typesense "github.com/aliml92/go-typesense/typesense"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClientAPI is a mock of ClientAPI interface.
+type MockClientAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientAPIMockRecorder
+}
+
+// MockClientAPIMockRecorder is the mock recorder for MockClientAPI.
+type MockClientAPIMockRecorder struct {
+	mock *MockClientAPI
+}
+
+// NewMockClientAPI creates a new mock instance.
+func NewMockClientAPI(ctrl *gomock.Controller) *MockClientAPI {
+	mock := &MockClientAPI{ctrl: ctrl}
+	mock.recorder = &MockClientAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientAPI) EXPECT() *MockClientAPIMockRecorder {
+	return m.recorder
+}
+
+// AliasesAPI mocks base method.
+func (m *MockClientAPI) AliasesAPI() typesense.AliasesAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasesAPI")
+	ret0, _ := ret[0].(typesense.AliasesAPI)
+	return ret0
+}
+
+// AliasesAPI indicates an expected call of AliasesAPI.
+func (mr *MockClientAPIMockRecorder) AliasesAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasesAPI", reflect.TypeOf((*MockClientAPI)(nil).AliasesAPI))
+}
+
+// AnalyticsEventsAPI mocks base method.
+func (m *MockClientAPI) AnalyticsEventsAPI() typesense.AnalyticsEventsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyticsEventsAPI")
+	ret0, _ := ret[0].(typesense.AnalyticsEventsAPI)
+	return ret0
+}
+
+// AnalyticsEventsAPI indicates an expected call of AnalyticsEventsAPI.
+func (mr *MockClientAPIMockRecorder) AnalyticsEventsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyticsEventsAPI", reflect.TypeOf((*MockClientAPI)(nil).AnalyticsEventsAPI))
+}
+
+// AnalyticsRulesAPI mocks base method.
+func (m *MockClientAPI) AnalyticsRulesAPI() typesense.AnalyticsRulesAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyticsRulesAPI")
+	ret0, _ := ret[0].(typesense.AnalyticsRulesAPI)
+	return ret0
+}
+
+// AnalyticsRulesAPI indicates an expected call of AnalyticsRulesAPI.
+func (mr *MockClientAPIMockRecorder) AnalyticsRulesAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyticsRulesAPI", reflect.TypeOf((*MockClientAPI)(nil).AnalyticsRulesAPI))
+}
+
+// CollectionsAPI mocks base method.
+func (m *MockClientAPI) CollectionsAPI() typesense.CollectionsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectionsAPI")
+	ret0, _ := ret[0].(typesense.CollectionsAPI)
+	return ret0
+}
+
+// CollectionsAPI indicates an expected call of CollectionsAPI.
+func (mr *MockClientAPIMockRecorder) CollectionsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionsAPI", reflect.TypeOf((*MockClientAPI)(nil).CollectionsAPI))
+}
+
+// ConversationsAPI mocks base method.
+func (m *MockClientAPI) ConversationsAPI() typesense.ConversationsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConversationsAPI")
+	ret0, _ := ret[0].(typesense.ConversationsAPI)
+	return ret0
+}
+
+// ConversationsAPI indicates an expected call of ConversationsAPI.
+func (mr *MockClientAPIMockRecorder) ConversationsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConversationsAPI", reflect.TypeOf((*MockClientAPI)(nil).ConversationsAPI))
+}
+
+// DocumentsAPI mocks base method.
+func (m *MockClientAPI) DocumentsAPI() typesense.DocumentsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DocumentsAPI")
+	ret0, _ := ret[0].(typesense.DocumentsAPI)
+	return ret0
+}
+
+// DocumentsAPI indicates an expected call of DocumentsAPI.
+func (mr *MockClientAPIMockRecorder) DocumentsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DocumentsAPI", reflect.TypeOf((*MockClientAPI)(nil).DocumentsAPI))
+}
+
+// KeysAPI mocks base method.
+func (m *MockClientAPI) KeysAPI() typesense.KeysAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeysAPI")
+	ret0, _ := ret[0].(typesense.KeysAPI)
+	return ret0
+}
+
+// KeysAPI indicates an expected call of KeysAPI.
+func (mr *MockClientAPIMockRecorder) KeysAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeysAPI", reflect.TypeOf((*MockClientAPI)(nil).KeysAPI))
+}
+
+// MetaAPI mocks base method.
+func (m *MockClientAPI) MetaAPI() typesense.MetaAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetaAPI")
+	ret0, _ := ret[0].(typesense.MetaAPI)
+	return ret0
+}
+
+// MetaAPI indicates an expected call of MetaAPI.
+func (mr *MockClientAPIMockRecorder) MetaAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetaAPI", reflect.TypeOf((*MockClientAPI)(nil).MetaAPI))
+}
+
+// OperationsAPI mocks base method.
+func (m *MockClientAPI) OperationsAPI() typesense.OperationsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperationsAPI")
+	ret0, _ := ret[0].(typesense.OperationsAPI)
+	return ret0
+}
+
+// OperationsAPI indicates an expected call of OperationsAPI.
+func (mr *MockClientAPIMockRecorder) OperationsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperationsAPI", reflect.TypeOf((*MockClientAPI)(nil).OperationsAPI))
+}
+
+// OverridesAPI mocks base method.
+func (m *MockClientAPI) OverridesAPI() typesense.OverridesAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverridesAPI")
+	ret0, _ := ret[0].(typesense.OverridesAPI)
+	return ret0
+}
+
+// OverridesAPI indicates an expected call of OverridesAPI.
+func (mr *MockClientAPIMockRecorder) OverridesAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverridesAPI", reflect.TypeOf((*MockClientAPI)(nil).OverridesAPI))
+}
+
+// PresetsAPI mocks base method.
+func (m *MockClientAPI) PresetsAPI() typesense.PresetsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresetsAPI")
+	ret0, _ := ret[0].(typesense.PresetsAPI)
+	return ret0
+}
+
+// PresetsAPI indicates an expected call of PresetsAPI.
+func (mr *MockClientAPIMockRecorder) PresetsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresetsAPI", reflect.TypeOf((*MockClientAPI)(nil).PresetsAPI))
+}
+
+// RateLimitsAPI mocks base method.
+func (m *MockClientAPI) RateLimitsAPI() typesense.RateLimitsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateLimitsAPI")
+	ret0, _ := ret[0].(typesense.RateLimitsAPI)
+	return ret0
+}
+
+// RateLimitsAPI indicates an expected call of RateLimitsAPI.
+func (mr *MockClientAPIMockRecorder) RateLimitsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateLimitsAPI", reflect.TypeOf((*MockClientAPI)(nil).RateLimitsAPI))
+}
+
+// StopwordsAPI mocks base method.
+func (m *MockClientAPI) StopwordsAPI() typesense.StopwordsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopwordsAPI")
+	ret0, _ := ret[0].(typesense.StopwordsAPI)
+	return ret0
+}
+
+// StopwordsAPI indicates an expected call of StopwordsAPI.
+func (mr *MockClientAPIMockRecorder) StopwordsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopwordsAPI", reflect.TypeOf((*MockClientAPI)(nil).StopwordsAPI))
+}
+
+// SynonymsAPI mocks base method.
+func (m *MockClientAPI) SynonymsAPI() typesense.SynonymsAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SynonymsAPI")
+	ret0, _ := ret[0].(typesense.SynonymsAPI)
+	return ret0
+}
+
+// SynonymsAPI indicates an expected call of SynonymsAPI.
+func (mr *MockClientAPIMockRecorder) SynonymsAPI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SynonymsAPI", reflect.TypeOf((*MockClientAPI)(nil).SynonymsAPI))
+}
+
+// MockCollectionsAPI is a mock of CollectionsAPI interface.
+type MockCollectionsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollectionsAPIMockRecorder
+}
+
+// MockCollectionsAPIMockRecorder is the mock recorder for MockCollectionsAPI.
+type MockCollectionsAPIMockRecorder struct {
+	mock *MockCollectionsAPI
+}
+
+// NewMockCollectionsAPI creates a new mock instance.
+func NewMockCollectionsAPI(ctrl *gomock.Controller) *MockCollectionsAPI {
+	mock := &MockCollectionsAPI{ctrl: ctrl}
+	mock.recorder = &MockCollectionsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollectionsAPI) EXPECT() *MockCollectionsAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCollectionsAPI) Create(ctx context.Context, body *typesense.CollectionSchema) (*typesense.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, body)
+	ret0, _ := ret[0].(*typesense.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCollectionsAPIMockRecorder) Create(ctx, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCollectionsAPI)(nil).Create), ctx, body)
+}
+
+// Delete mocks base method.
+func (m *MockCollectionsAPI) Delete(ctx context.Context, collectionName string) (*typesense.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, collectionName)
+	ret0, _ := ret[0].(*typesense.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCollectionsAPIMockRecorder) Delete(ctx, collectionName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCollectionsAPI)(nil).Delete), ctx, collectionName)
+}
+
+// Get mocks base method.
+func (m *MockCollectionsAPI) Get(ctx context.Context, collectionName string) (*typesense.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, collectionName)
+	ret0, _ := ret[0].(*typesense.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCollectionsAPIMockRecorder) Get(ctx, collectionName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCollectionsAPI)(nil).Get), ctx, collectionName)
+}
+
+// List mocks base method.
+func (m *MockCollectionsAPI) List(ctx context.Context) ([]*typesense.CollectionSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*typesense.CollectionSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCollectionsAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCollectionsAPI)(nil).List), ctx)
+}
+
+// Update mocks base method.
+func (m *MockCollectionsAPI) Update(ctx context.Context, collectionName string, body *typesense.CollectionUpdateSchema) (*typesense.CollectionUpdateSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, collectionName, body)
+	ret0, _ := ret[0].(*typesense.CollectionUpdateSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCollectionsAPIMockRecorder) Update(ctx, collectionName, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCollectionsAPI)(nil).Update), ctx, collectionName, body)
+}
+
+// MockDocumentsAPI is a mock of DocumentsAPI interface.
+type MockDocumentsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentsAPIMockRecorder
+}
+
+// MockDocumentsAPIMockRecorder is the mock recorder for MockDocumentsAPI.
+type MockDocumentsAPIMockRecorder struct {
+	mock *MockDocumentsAPI
+}
+
+// NewMockDocumentsAPI creates a new mock instance.
+func NewMockDocumentsAPI(ctrl *gomock.Controller) *MockDocumentsAPI {
+	mock := &MockDocumentsAPI{ctrl: ctrl}
+	mock.recorder = &MockDocumentsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDocumentsAPI) EXPECT() *MockDocumentsAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDocumentsAPI) Create(ctx context.Context, collectionName string, body any) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, collectionName, body)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDocumentsAPIMockRecorder) Create(ctx, collectionName, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDocumentsAPI)(nil).Create), ctx, collectionName, body)
+}
+
+// Delete mocks base method.
+func (m *MockDocumentsAPI) Delete(ctx context.Context, collectionName, documentId string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, collectionName, documentId)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDocumentsAPIMockRecorder) Delete(ctx, collectionName, documentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDocumentsAPI)(nil).Delete), ctx, collectionName, documentId)
+}
+
+// DeleteByQuery mocks base method.
+func (m *MockDocumentsAPI) DeleteByQuery(ctx context.Context, collectionName string, opts *typesense.DeleteOptions) (*typesense.DeleteByQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByQuery", ctx, collectionName, opts)
+	ret0, _ := ret[0].(*typesense.DeleteByQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByQuery indicates an expected call of DeleteByQuery.
+func (mr *MockDocumentsAPIMockRecorder) DeleteByQuery(ctx, collectionName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByQuery", reflect.TypeOf((*MockDocumentsAPI)(nil).DeleteByQuery), ctx, collectionName, opts)
+}
+
+// Export mocks base method.
+func (m *MockDocumentsAPI) Export(ctx context.Context, collectionName string, opts *typesense.ExportDocumentsParams) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, collectionName, opts)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockDocumentsAPIMockRecorder) Export(ctx, collectionName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockDocumentsAPI)(nil).Export), ctx, collectionName, opts)
+}
+
+// ExportJSONL mocks base method.
+func (m *MockDocumentsAPI) ExportJSONL(ctx context.Context, collectionName string, w io.Writer, opts *typesense.ExportDocumentsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportJSONL", ctx, collectionName, w, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportJSONL indicates an expected call of ExportJSONL.
+func (mr *MockDocumentsAPIMockRecorder) ExportJSONL(ctx, collectionName, w, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportJSONL", reflect.TypeOf((*MockDocumentsAPI)(nil).ExportJSONL), ctx, collectionName, w, opts)
+}
+
+// Get mocks base method.
+func (m *MockDocumentsAPI) Get(ctx context.Context, collectionName, documentId string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, collectionName, documentId)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDocumentsAPIMockRecorder) Get(ctx, collectionName, documentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDocumentsAPI)(nil).Get), ctx, collectionName, documentId)
+}
+
+// Import mocks base method.
+func (m *MockDocumentsAPI) Import(ctx context.Context, collectionName string, body []map[string]any, opts *typesense.ImportDocumentsParams) ([]*typesense.ImportDocumentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, collectionName, body, opts)
+	ret0, _ := ret[0].([]*typesense.ImportDocumentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockDocumentsAPIMockRecorder) Import(ctx, collectionName, body, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockDocumentsAPI)(nil).Import), ctx, collectionName, body, opts)
+}
+
+// ImportJSONL mocks base method.
+func (m *MockDocumentsAPI) ImportJSONL(ctx context.Context, collectionName string, r io.Reader, opts *typesense.ImportDocumentsParams) ([]*typesense.ImportDocumentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJSONL", ctx, collectionName, r, opts)
+	ret0, _ := ret[0].([]*typesense.ImportDocumentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportJSONL indicates an expected call of ImportJSONL.
+func (mr *MockDocumentsAPIMockRecorder) ImportJSONL(ctx, collectionName, r, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJSONL", reflect.TypeOf((*MockDocumentsAPI)(nil).ImportJSONL), ctx, collectionName, r, opts)
+}
+
+// MultiSearch mocks base method.
+func (m *MockDocumentsAPI) MultiSearch(ctx context.Context, body *typesense.MultiSearchSearchesParameter, opts *typesense.MultiSearchParameters) (*typesense.MultiSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiSearch", ctx, body, opts)
+	ret0, _ := ret[0].(*typesense.MultiSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiSearch indicates an expected call of MultiSearch.
+func (mr *MockDocumentsAPIMockRecorder) MultiSearch(ctx, body, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSearch", reflect.TypeOf((*MockDocumentsAPI)(nil).MultiSearch), ctx, body, opts)
+}
+
+// Search mocks base method.
+func (m *MockDocumentsAPI) Search(ctx context.Context, collectionName string, opts *typesense.SearchParameters) (*typesense.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, collectionName, opts)
+	ret0, _ := ret[0].(*typesense.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockDocumentsAPIMockRecorder) Search(ctx, collectionName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockDocumentsAPI)(nil).Search), ctx, collectionName, opts)
+}
+
+// Update mocks base method.
+func (m *MockDocumentsAPI) Update(ctx context.Context, collectionName, documentId string, body any) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, collectionName, documentId, body)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDocumentsAPIMockRecorder) Update(ctx, collectionName, documentId, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDocumentsAPI)(nil).Update), ctx, collectionName, documentId, body)
+}
+
+// UpdateByQuery mocks base method.
+func (m *MockDocumentsAPI) UpdateByQuery(ctx context.Context, collectionName, body any, opts *typesense.UpdateOptions) (*typesense.UpdateByQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByQuery", ctx, collectionName, body, opts)
+	ret0, _ := ret[0].(*typesense.UpdateByQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateByQuery indicates an expected call of UpdateByQuery.
+func (mr *MockDocumentsAPIMockRecorder) UpdateByQuery(ctx, collectionName, body, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByQuery", reflect.TypeOf((*MockDocumentsAPI)(nil).UpdateByQuery), ctx, collectionName, body, opts)
+}
+
+// MockKeysAPI is a mock of KeysAPI interface.
+type MockKeysAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeysAPIMockRecorder
+}
+
+// MockKeysAPIMockRecorder is the mock recorder for MockKeysAPI.
+type MockKeysAPIMockRecorder struct {
+	mock *MockKeysAPI
+}
+
+// NewMockKeysAPI creates a new mock instance.
+func NewMockKeysAPI(ctrl *gomock.Controller) *MockKeysAPI {
+	mock := &MockKeysAPI{ctrl: ctrl}
+	mock.recorder = &MockKeysAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeysAPI) EXPECT() *MockKeysAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockKeysAPI) Create(ctx context.Context, body *typesense.ApiKeySchema) (*typesense.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, body)
+	ret0, _ := ret[0].(*typesense.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockKeysAPIMockRecorder) Create(ctx, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKeysAPI)(nil).Create), ctx, body)
+}
+
+// Delete mocks base method.
+func (m *MockKeysAPI) Delete(ctx context.Context, keyId int) (*typesense.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, keyId)
+	ret0, _ := ret[0].(*typesense.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockKeysAPIMockRecorder) Delete(ctx, keyId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeysAPI)(nil).Delete), ctx, keyId)
+}
+
+// Get mocks base method.
+func (m *MockKeysAPI) Get(ctx context.Context, keyId int) (*typesense.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, keyId)
+	ret0, _ := ret[0].(*typesense.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockKeysAPIMockRecorder) Get(ctx, keyId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeysAPI)(nil).Get), ctx, keyId)
+}
+
+// List mocks base method.
+func (m *MockKeysAPI) List(ctx context.Context) (*typesense.ApiKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*typesense.ApiKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockKeysAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeysAPI)(nil).List), ctx)
+}
+
+// MockRateLimitsAPI is a mock of RateLimitsAPI interface.
+type MockRateLimitsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitsAPIMockRecorder
+}
+
+// MockRateLimitsAPIMockRecorder is the mock recorder for MockRateLimitsAPI.
+type MockRateLimitsAPIMockRecorder struct {
+	mock *MockRateLimitsAPI
+}
+
+// NewMockRateLimitsAPI creates a new mock instance.
+func NewMockRateLimitsAPI(ctrl *gomock.Controller) *MockRateLimitsAPI {
+	mock := &MockRateLimitsAPI{ctrl: ctrl}
+	mock.recorder = &MockRateLimitsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitsAPI) EXPECT() *MockRateLimitsAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRateLimitsAPI) Create(ctx context.Context, body *typesense.RateLimitRuleSchema) (*typesense.RateLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, body)
+	ret0, _ := ret[0].(*typesense.RateLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRateLimitsAPIMockRecorder) Create(ctx, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRateLimitsAPI)(nil).Create), ctx, body)
+}
+
+// Delete mocks base method.
+func (m *MockRateLimitsAPI) Delete(ctx context.Context, id int) (*typesense.DeleteRateLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*typesense.DeleteRateLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRateLimitsAPIMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRateLimitsAPI)(nil).Delete), ctx, id)
+}
+
+// DeleteActive mocks base method.
+func (m *MockRateLimitsAPI) DeleteActive(ctx context.Context, id int) (*typesense.DeleteRateLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActive", ctx, id)
+	ret0, _ := ret[0].(*typesense.DeleteRateLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteActive indicates an expected call of DeleteActive.
+func (mr *MockRateLimitsAPIMockRecorder) DeleteActive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActive", reflect.TypeOf((*MockRateLimitsAPI)(nil).DeleteActive), ctx, id)
+}
+
+// DeleteExceeds mocks base method.
+func (m *MockRateLimitsAPI) DeleteExceeds(ctx context.Context, id int) (*typesense.DeleteRateLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExceeds", ctx, id)
+	ret0, _ := ret[0].(*typesense.DeleteRateLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExceeds indicates an expected call of DeleteExceeds.
+func (mr *MockRateLimitsAPIMockRecorder) DeleteExceeds(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExceeds", reflect.TypeOf((*MockRateLimitsAPI)(nil).DeleteExceeds), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockRateLimitsAPI) Get(ctx context.Context, id int) (*typesense.RateLimitRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*typesense.RateLimitRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRateLimitsAPIMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRateLimitsAPI)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRateLimitsAPI) List(ctx context.Context) ([]*typesense.RateLimitRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*typesense.RateLimitRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRateLimitsAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRateLimitsAPI)(nil).List), ctx)
+}
+
+// ListActive mocks base method.
+func (m *MockRateLimitsAPI) ListActive(ctx context.Context) ([]*typesense.RateLimitStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActive", ctx)
+	ret0, _ := ret[0].([]*typesense.RateLimitStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActive indicates an expected call of ListActive.
+func (mr *MockRateLimitsAPIMockRecorder) ListActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActive", reflect.TypeOf((*MockRateLimitsAPI)(nil).ListActive), ctx)
+}
+
+// ListExceeds mocks base method.
+func (m *MockRateLimitsAPI) ListExceeds(ctx context.Context) ([]*typesense.RateLimitExceed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExceeds", ctx)
+	ret0, _ := ret[0].([]*typesense.RateLimitExceed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExceeds indicates an expected call of ListExceeds.
+func (mr *MockRateLimitsAPIMockRecorder) ListExceeds(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExceeds", reflect.TypeOf((*MockRateLimitsAPI)(nil).ListExceeds), ctx)
+}
+
+// Update mocks base method.
+func (m *MockRateLimitsAPI) Update(ctx context.Context, id int, body *typesense.RateLimitRuleSchema) (*typesense.RateLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, body)
+	ret0, _ := ret[0].(*typesense.RateLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRateLimitsAPIMockRecorder) Update(ctx, id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRateLimitsAPI)(nil).Update), ctx, id, body)
+}
+
+// MockOperationsAPI is a mock of OperationsAPI interface.
+type MockOperationsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationsAPIMockRecorder
+}
+
+// MockOperationsAPIMockRecorder is the mock recorder for MockOperationsAPI.
+type MockOperationsAPIMockRecorder struct {
+	mock *MockOperationsAPI
+}
+
+// NewMockOperationsAPI creates a new mock instance.
+func NewMockOperationsAPI(ctrl *gomock.Controller) *MockOperationsAPI {
+	mock := &MockOperationsAPI{ctrl: ctrl}
+	mock.recorder = &MockOperationsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperationsAPI) EXPECT() *MockOperationsAPIMockRecorder {
+	return m.recorder
+}
+
+// ClearCache mocks base method.
+func (m *MockOperationsAPI) ClearCache(ctx context.Context) (*typesense.SuccessStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCache", ctx)
+	ret0, _ := ret[0].(*typesense.SuccessStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearCache indicates an expected call of ClearCache.
+func (mr *MockOperationsAPIMockRecorder) ClearCache(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCache", reflect.TypeOf((*MockOperationsAPI)(nil).ClearCache), ctx)
+}
+
+// CompactDB mocks base method.
+func (m *MockOperationsAPI) CompactDB(ctx context.Context) (*typesense.SuccessStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactDB", ctx)
+	ret0, _ := ret[0].(*typesense.SuccessStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactDB indicates an expected call of CompactDB.
+func (mr *MockOperationsAPIMockRecorder) CompactDB(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactDB", reflect.TypeOf((*MockOperationsAPI)(nil).CompactDB), ctx)
+}
+
+// ResetPeers mocks base method.
+func (m *MockOperationsAPI) ResetPeers(ctx context.Context) (*typesense.SuccessStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPeers", ctx)
+	ret0, _ := ret[0].(*typesense.SuccessStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPeers indicates an expected call of ResetPeers.
+func (mr *MockOperationsAPIMockRecorder) ResetPeers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPeers", reflect.TypeOf((*MockOperationsAPI)(nil).ResetPeers), ctx)
+}
+
+// Snapshot mocks base method.
+func (m *MockOperationsAPI) Snapshot(ctx context.Context, opts *typesense.TakeSnapshotParams) (*typesense.SuccessStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", ctx, opts)
+	ret0, _ := ret[0].(*typesense.SuccessStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockOperationsAPIMockRecorder) Snapshot(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockOperationsAPI)(nil).Snapshot), ctx, opts)
+}
+
+// Vote mocks base method.
+func (m *MockOperationsAPI) Vote(ctx context.Context) (*typesense.SuccessStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", ctx)
+	ret0, _ := ret[0].(*typesense.SuccessStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockOperationsAPIMockRecorder) Vote(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockOperationsAPI)(nil).Vote), ctx)
+}
+
+// MockMetaAPI is a mock of MetaAPI interface.
+type MockMetaAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetaAPIMockRecorder
+}
+
+// MockMetaAPIMockRecorder is the mock recorder for MockMetaAPI.
+type MockMetaAPIMockRecorder struct {
+	mock *MockMetaAPI
+}
+
+// NewMockMetaAPI creates a new mock instance.
+func NewMockMetaAPI(ctrl *gomock.Controller) *MockMetaAPI {
+	mock := &MockMetaAPI{ctrl: ctrl}
+	mock.recorder = &MockMetaAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetaAPI) EXPECT() *MockMetaAPIMockRecorder {
+	return m.recorder
+}
+
+// Config mocks base method.
+func (m *MockMetaAPI) Config(ctx context.Context, body *typesense.Config) (*typesense.SuccessStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Config", ctx, body)
+	ret0, _ := ret[0].(*typesense.SuccessStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Config indicates an expected call of Config.
+func (mr *MockMetaAPIMockRecorder) Config(ctx, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockMetaAPI)(nil).Config), ctx, body)
+}
+
+// Debug mocks base method.
+func (m *MockMetaAPI) Debug(ctx context.Context) (*typesense.Debug, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Debug", ctx)
+	ret0, _ := ret[0].(*typesense.Debug)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Debug indicates an expected call of Debug.
+func (mr *MockMetaAPIMockRecorder) Debug(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Debug", reflect.TypeOf((*MockMetaAPI)(nil).Debug), ctx)
+}
+
+// Health mocks base method.
+func (m *MockMetaAPI) Health(ctx context.Context) (*typesense.HealthStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health", ctx)
+	ret0, _ := ret[0].(*typesense.HealthStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Health indicates an expected call of Health.
+func (mr *MockMetaAPIMockRecorder) Health(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockMetaAPI)(nil).Health), ctx)
+}
+
+// Metrics mocks base method.
+func (m *MockMetaAPI) Metrics(ctx context.Context) (*typesense.Metrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Metrics", ctx)
+	ret0, _ := ret[0].(*typesense.Metrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Metrics indicates an expected call of Metrics.
+func (mr *MockMetaAPIMockRecorder) Metrics(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metrics", reflect.TypeOf((*MockMetaAPI)(nil).Metrics), ctx)
+}
+
+// Stats mocks base method.
+func (m *MockMetaAPI) Stats(ctx context.Context) (*typesense.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx)
+	ret0, _ := ret[0].(*typesense.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockMetaAPIMockRecorder) Stats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockMetaAPI)(nil).Stats), ctx)
+}
+
+// Status mocks base method.
+func (m *MockMetaAPI) Status(ctx context.Context) (*typesense.NodeStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx)
+	ret0, _ := ret[0].(*typesense.NodeStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockMetaAPIMockRecorder) Status(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockMetaAPI)(nil).Status), ctx)
+}
+
+// MockOverridesAPI is a mock of OverridesAPI interface.
+type MockOverridesAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockOverridesAPIMockRecorder
+}
+
+// MockOverridesAPIMockRecorder is the mock recorder for MockOverridesAPI.
+type MockOverridesAPIMockRecorder struct {
+	mock *MockOverridesAPI
+}
+
+// NewMockOverridesAPI creates a new mock instance.
+func NewMockOverridesAPI(ctrl *gomock.Controller) *MockOverridesAPI {
+	mock := &MockOverridesAPI{ctrl: ctrl}
+	mock.recorder = &MockOverridesAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOverridesAPI) EXPECT() *MockOverridesAPIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockOverridesAPI) Delete(ctx context.Context, collectionName, overrideId string) (*typesense.DeleteOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, collectionName, overrideId)
+	ret0, _ := ret[0].(*typesense.DeleteOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOverridesAPIMockRecorder) Delete(ctx, collectionName, overrideId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOverridesAPI)(nil).Delete), ctx, collectionName, overrideId)
+}
+
+// Get mocks base method.
+func (m *MockOverridesAPI) Get(ctx context.Context, collectionName, overrideId string) (*typesense.SearchOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, collectionName, overrideId)
+	ret0, _ := ret[0].(*typesense.SearchOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOverridesAPIMockRecorder) Get(ctx, collectionName, overrideId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOverridesAPI)(nil).Get), ctx, collectionName, overrideId)
+}
+
+// List mocks base method.
+func (m *MockOverridesAPI) List(ctx context.Context, collectionName string) (*typesense.SearchOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, collectionName)
+	ret0, _ := ret[0].(*typesense.SearchOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockOverridesAPIMockRecorder) List(ctx, collectionName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOverridesAPI)(nil).List), ctx, collectionName)
+}
+
+// Upsert mocks base method.
+func (m *MockOverridesAPI) Upsert(ctx context.Context, collectionName, overrideId string, body *typesense.SearchOverrideSchema) (*typesense.SearchOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, collectionName, overrideId, body)
+	ret0, _ := ret[0].(*typesense.SearchOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockOverridesAPIMockRecorder) Upsert(ctx, collectionName, overrideId, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockOverridesAPI)(nil).Upsert), ctx, collectionName, overrideId, body)
+}
+
+// MockAliasesAPI is a mock of AliasesAPI interface.
+type MockAliasesAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAliasesAPIMockRecorder
+}
+
+// MockAliasesAPIMockRecorder is the mock recorder for MockAliasesAPI.
+type MockAliasesAPIMockRecorder struct {
+	mock *MockAliasesAPI
+}
+
+// NewMockAliasesAPI creates a new mock instance.
+func NewMockAliasesAPI(ctrl *gomock.Controller) *MockAliasesAPI {
+	mock := &MockAliasesAPI{ctrl: ctrl}
+	mock.recorder = &MockAliasesAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAliasesAPI) EXPECT() *MockAliasesAPIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAliasesAPI) Delete(ctx context.Context, aliasName string) (*typesense.CollectionAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, aliasName)
+	ret0, _ := ret[0].(*typesense.CollectionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAliasesAPIMockRecorder) Delete(ctx, aliasName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAliasesAPI)(nil).Delete), ctx, aliasName)
+}
+
+// Get mocks base method.
+func (m *MockAliasesAPI) Get(ctx context.Context, aliasName string) (*typesense.CollectionAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, aliasName)
+	ret0, _ := ret[0].(*typesense.CollectionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAliasesAPIMockRecorder) Get(ctx, aliasName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAliasesAPI)(nil).Get), ctx, aliasName)
+}
+
+// List mocks base method.
+func (m *MockAliasesAPI) List(ctx context.Context) ([]*typesense.CollectionAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*typesense.CollectionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAliasesAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAliasesAPI)(nil).List), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockAliasesAPI) Upsert(ctx context.Context, aliasName string, body *typesense.CollectionAliasSchema) (*typesense.CollectionAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, aliasName, body)
+	ret0, _ := ret[0].(*typesense.CollectionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockAliasesAPIMockRecorder) Upsert(ctx, aliasName, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAliasesAPI)(nil).Upsert), ctx, aliasName, body)
+}
+
+// MockAnalyticsRulesAPI is a mock of AnalyticsRulesAPI interface.
+type MockAnalyticsRulesAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsRulesAPIMockRecorder
+}
+
+// MockAnalyticsRulesAPIMockRecorder is the mock recorder for MockAnalyticsRulesAPI.
+type MockAnalyticsRulesAPIMockRecorder struct {
+	mock *MockAnalyticsRulesAPI
+}
+
+// NewMockAnalyticsRulesAPI creates a new mock instance.
+func NewMockAnalyticsRulesAPI(ctrl *gomock.Controller) *MockAnalyticsRulesAPI {
+	mock := &MockAnalyticsRulesAPI{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsRulesAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsRulesAPI) EXPECT() *MockAnalyticsRulesAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAnalyticsRulesAPI) Create(ctx context.Context, body *typesense.AnalyticsRule) (*typesense.AnalyticsRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, body)
+	ret0, _ := ret[0].(*typesense.AnalyticsRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAnalyticsRulesAPIMockRecorder) Create(ctx, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAnalyticsRulesAPI)(nil).Create), ctx, body)
+}
+
+// Delete mocks base method.
+func (m *MockAnalyticsRulesAPI) Delete(ctx context.Context, ruleName string) (*typesense.AnalyticsRuleDeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ruleName)
+	ret0, _ := ret[0].(*typesense.AnalyticsRuleDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAnalyticsRulesAPIMockRecorder) Delete(ctx, ruleName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAnalyticsRulesAPI)(nil).Delete), ctx, ruleName)
+}
+
+// Get mocks base method.
+func (m *MockAnalyticsRulesAPI) Get(ctx context.Context, ruleName string) (*typesense.AnalyticsRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ruleName)
+	ret0, _ := ret[0].(*typesense.AnalyticsRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAnalyticsRulesAPIMockRecorder) Get(ctx, ruleName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAnalyticsRulesAPI)(nil).Get), ctx, ruleName)
+}
+
+// List mocks base method.
+func (m *MockAnalyticsRulesAPI) List(ctx context.Context) (*typesense.AnalyticsRuleListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*typesense.AnalyticsRuleListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAnalyticsRulesAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAnalyticsRulesAPI)(nil).List), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockAnalyticsRulesAPI) Upsert(ctx context.Context, ruleName string, body *typesense.AnalyticsRuleUpsertSchema) (*typesense.AnalyticsRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, ruleName, body)
+	ret0, _ := ret[0].(*typesense.AnalyticsRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockAnalyticsRulesAPIMockRecorder) Upsert(ctx, ruleName, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAnalyticsRulesAPI)(nil).Upsert), ctx, ruleName, body)
+}
+
+// MockAnalyticsEventsAPI is a mock of AnalyticsEventsAPI interface.
+type MockAnalyticsEventsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsEventsAPIMockRecorder
+}
+
+// MockAnalyticsEventsAPIMockRecorder is the mock recorder for MockAnalyticsEventsAPI.
+type MockAnalyticsEventsAPIMockRecorder struct {
+	mock *MockAnalyticsEventsAPI
+}
+
+// NewMockAnalyticsEventsAPI creates a new mock instance.
+func NewMockAnalyticsEventsAPI(ctrl *gomock.Controller) *MockAnalyticsEventsAPI {
+	mock := &MockAnalyticsEventsAPI{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsEventsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsEventsAPI) EXPECT() *MockAnalyticsEventsAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAnalyticsEventsAPI) Create(ctx context.Context, body *typesense.AnalyticsEvent) (*typesense.AnalyticsEventCreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, body)
+	ret0, _ := ret[0].(*typesense.AnalyticsEventCreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAnalyticsEventsAPIMockRecorder) Create(ctx, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAnalyticsEventsAPI)(nil).Create), ctx, body)
+}
+
+// MockPresetsAPI is a mock of PresetsAPI interface.
+type MockPresetsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPresetsAPIMockRecorder
+}
+
+// MockPresetsAPIMockRecorder is the mock recorder for MockPresetsAPI.
+type MockPresetsAPIMockRecorder struct {
+	mock *MockPresetsAPI
+}
+
+// NewMockPresetsAPI creates a new mock instance.
+func NewMockPresetsAPI(ctrl *gomock.Controller) *MockPresetsAPI {
+	mock := &MockPresetsAPI{ctrl: ctrl}
+	mock.recorder = &MockPresetsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPresetsAPI) EXPECT() *MockPresetsAPIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockPresetsAPI) Delete(ctx context.Context, presetName string) (*typesense.Preset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, presetName)
+	ret0, _ := ret[0].(*typesense.Preset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPresetsAPIMockRecorder) Delete(ctx, presetName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPresetsAPI)(nil).Delete), ctx, presetName)
+}
+
+// Get mocks base method.
+func (m *MockPresetsAPI) Get(ctx context.Context, presetName string) (*typesense.Preset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, presetName)
+	ret0, _ := ret[0].(*typesense.Preset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPresetsAPIMockRecorder) Get(ctx, presetName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPresetsAPI)(nil).Get), ctx, presetName)
+}
+
+// List mocks base method.
+func (m *MockPresetsAPI) List(ctx context.Context) (*typesense.PresetListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*typesense.PresetListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPresetsAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPresetsAPI)(nil).List), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockPresetsAPI) Upsert(ctx context.Context, presetName string, body *typesense.PresetUpsertSchema) (*typesense.Preset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, presetName, body)
+	ret0, _ := ret[0].(*typesense.Preset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockPresetsAPIMockRecorder) Upsert(ctx, presetName, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockPresetsAPI)(nil).Upsert), ctx, presetName, body)
+}
+
+// MockSynonymsAPI is a mock of SynonymsAPI interface.
+type MockSynonymsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSynonymsAPIMockRecorder
+}
+
+// MockSynonymsAPIMockRecorder is the mock recorder for MockSynonymsAPI.
+type MockSynonymsAPIMockRecorder struct {
+	mock *MockSynonymsAPI
+}
+
+// NewMockSynonymsAPI creates a new mock instance.
+func NewMockSynonymsAPI(ctrl *gomock.Controller) *MockSynonymsAPI {
+	mock := &MockSynonymsAPI{ctrl: ctrl}
+	mock.recorder = &MockSynonymsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSynonymsAPI) EXPECT() *MockSynonymsAPIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSynonymsAPI) Delete(ctx context.Context, collectionName, synonymId string) (*typesense.DeleteSynonymResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, collectionName, synonymId)
+	ret0, _ := ret[0].(*typesense.DeleteSynonymResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSynonymsAPIMockRecorder) Delete(ctx, collectionName, synonymId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSynonymsAPI)(nil).Delete), ctx, collectionName, synonymId)
+}
+
+// Get mocks base method.
+func (m *MockSynonymsAPI) Get(ctx context.Context, collectionName, synonymId string) (*typesense.SearchSynonym, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, collectionName, synonymId)
+	ret0, _ := ret[0].(*typesense.SearchSynonym)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSynonymsAPIMockRecorder) Get(ctx, collectionName, synonymId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSynonymsAPI)(nil).Get), ctx, collectionName, synonymId)
+}
+
+// List mocks base method.
+func (m *MockSynonymsAPI) List(ctx context.Context, collectionName string) (*typesense.SearchSynonymsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, collectionName)
+	ret0, _ := ret[0].(*typesense.SearchSynonymsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSynonymsAPIMockRecorder) List(ctx, collectionName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSynonymsAPI)(nil).List), ctx, collectionName)
+}
+
+// Upsert mocks base method.
+func (m *MockSynonymsAPI) Upsert(ctx context.Context, collectionName, synonymId string, body *typesense.SearchSynonymSchema) (*typesense.SearchSynonym, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, collectionName, synonymId, body)
+	ret0, _ := ret[0].(*typesense.SearchSynonym)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockSynonymsAPIMockRecorder) Upsert(ctx, collectionName, synonymId, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockSynonymsAPI)(nil).Upsert), ctx, collectionName, synonymId, body)
+}
+
+// MockStopwordsAPI is a mock of StopwordsAPI interface.
+type MockStopwordsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStopwordsAPIMockRecorder
+}
+
+// MockStopwordsAPIMockRecorder is the mock recorder for MockStopwordsAPI.
+type MockStopwordsAPIMockRecorder struct {
+	mock *MockStopwordsAPI
+}
+
+// NewMockStopwordsAPI creates a new mock instance.
+func NewMockStopwordsAPI(ctrl *gomock.Controller) *MockStopwordsAPI {
+	mock := &MockStopwordsAPI{ctrl: ctrl}
+	mock.recorder = &MockStopwordsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStopwordsAPI) EXPECT() *MockStopwordsAPIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockStopwordsAPI) Delete(ctx context.Context, stopwordsSetId string) (*typesense.DeleteStopwordsSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, stopwordsSetId)
+	ret0, _ := ret[0].(*typesense.DeleteStopwordsSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStopwordsAPIMockRecorder) Delete(ctx, stopwordsSetId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStopwordsAPI)(nil).Delete), ctx, stopwordsSetId)
+}
+
+// Get mocks base method.
+func (m *MockStopwordsAPI) Get(ctx context.Context, stopwordsSetId string) (*typesense.StopwordsSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, stopwordsSetId)
+	ret0, _ := ret[0].(*typesense.StopwordsSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStopwordsAPIMockRecorder) Get(ctx, stopwordsSetId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStopwordsAPI)(nil).Get), ctx, stopwordsSetId)
+}
+
+// List mocks base method.
+func (m *MockStopwordsAPI) List(ctx context.Context) (*typesense.StopwordsSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*typesense.StopwordsSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockStopwordsAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStopwordsAPI)(nil).List), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockStopwordsAPI) Upsert(ctx context.Context, stopwordsSetId string, body *typesense.StopwordsSetSchema) (*typesense.StopwordsSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, stopwordsSetId, body)
+	ret0, _ := ret[0].(*typesense.StopwordsSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockStopwordsAPIMockRecorder) Upsert(ctx, stopwordsSetId, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockStopwordsAPI)(nil).Upsert), ctx, stopwordsSetId, body)
+}
+
+// MockConversationsAPI is a mock of ConversationsAPI interface.
+type MockConversationsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversationsAPIMockRecorder
+}
+
+// MockConversationsAPIMockRecorder is the mock recorder for MockConversationsAPI.
+type MockConversationsAPIMockRecorder struct {
+	mock *MockConversationsAPI
+}
+
+// NewMockConversationsAPI creates a new mock instance.
+func NewMockConversationsAPI(ctrl *gomock.Controller) *MockConversationsAPI {
+	mock := &MockConversationsAPI{ctrl: ctrl}
+	mock.recorder = &MockConversationsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversationsAPI) EXPECT() *MockConversationsAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateModel mocks base method.
+func (m *MockConversationsAPI) CreateModel(ctx context.Context, body *typesense.ConversationModelSchema) (*typesense.ConversationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModel", ctx, body)
+	ret0, _ := ret[0].(*typesense.ConversationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModel indicates an expected call of CreateModel.
+func (mr *MockConversationsAPIMockRecorder) CreateModel(ctx, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModel", reflect.TypeOf((*MockConversationsAPI)(nil).CreateModel), ctx, body)
+}
+
+// Delete mocks base method.
+func (m *MockConversationsAPI) Delete(ctx context.Context, conversationId string) (*typesense.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, conversationId)
+	ret0, _ := ret[0].(*typesense.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockConversationsAPIMockRecorder) Delete(ctx, conversationId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockConversationsAPI)(nil).Delete), ctx, conversationId)
+}
+
+// DeleteModel mocks base method.
+func (m *MockConversationsAPI) DeleteModel(ctx context.Context, modelId string) (*typesense.ConversationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModel", ctx, modelId)
+	ret0, _ := ret[0].(*typesense.ConversationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteModel indicates an expected call of DeleteModel.
+func (mr *MockConversationsAPIMockRecorder) DeleteModel(ctx, modelId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModel", reflect.TypeOf((*MockConversationsAPI)(nil).DeleteModel), ctx, modelId)
+}
+
+// Get mocks base method.
+func (m *MockConversationsAPI) Get(ctx context.Context, conversationId string) (*typesense.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, conversationId)
+	ret0, _ := ret[0].(*typesense.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockConversationsAPIMockRecorder) Get(ctx, conversationId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockConversationsAPI)(nil).Get), ctx, conversationId)
+}
+
+// GetModel mocks base method.
+func (m *MockConversationsAPI) GetModel(ctx context.Context, modelId string) (*typesense.ConversationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModel", ctx, modelId)
+	ret0, _ := ret[0].(*typesense.ConversationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModel indicates an expected call of GetModel.
+func (mr *MockConversationsAPIMockRecorder) GetModel(ctx, modelId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModel", reflect.TypeOf((*MockConversationsAPI)(nil).GetModel), ctx, modelId)
+}
+
+// List mocks base method.
+func (m *MockConversationsAPI) List(ctx context.Context) (*typesense.ConversationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*typesense.ConversationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockConversationsAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockConversationsAPI)(nil).List), ctx)
+}
+
+// ListModels mocks base method.
+func (m *MockConversationsAPI) ListModels(ctx context.Context) ([]*typesense.ConversationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModels", ctx)
+	ret0, _ := ret[0].([]*typesense.ConversationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModels indicates an expected call of ListModels.
+func (mr *MockConversationsAPIMockRecorder) ListModels(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockConversationsAPI)(nil).ListModels), ctx)
+}
+
+// Update mocks base method.
+func (m *MockConversationsAPI) Update(ctx context.Context, conversationId string, body *typesense.ConversationUpdateSchema) (*typesense.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, conversationId, body)
+	ret0, _ := ret[0].(*typesense.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockConversationsAPIMockRecorder) Update(ctx, conversationId, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockConversationsAPI)(nil).Update), ctx, conversationId, body)
+}
+
+// UpdateModel mocks base method.
+func (m *MockConversationsAPI) UpdateModel(ctx context.Context, modelId string, body *typesense.ConversationModelSchema) (*typesense.ConversationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModel", ctx, modelId, body)
+	ret0, _ := ret[0].(*typesense.ConversationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateModel indicates an expected call of UpdateModel.
+func (mr *MockConversationsAPIMockRecorder) UpdateModel(ctx, modelId, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModel", reflect.TypeOf((*MockConversationsAPI)(nil).UpdateModel), ctx, modelId, body)
+}
@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func countCompanies(ctx context.Context, client typesense.ClientAPI) (int, error) {
+	res, err := client.DocumentsAPI().Search(ctx, "companies", &typesense.SearchParameters{Q: "*"})
+	if err != nil {
+		return 0, err
+	}
+	return *res.Found, nil
+}
+
+func TestMockClientAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	documents := NewMockDocumentsAPI(ctrl)
+	documents.EXPECT().
+		Search(ctx, "companies", gomock.Any()).
+		Return(&typesense.SearchResult{Found: typesense.Int(42)}, nil)
+
+	client := NewMockClientAPI(ctrl)
+	client.EXPECT().DocumentsAPI().Return(documents)
+
+	n, err := countCompanies(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, 42, n)
+}
@@ -161,3 +161,26 @@ func TestDocumentsService_MultiSearch(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func TestDocumentsService_MultiSearchParameters(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/multi_search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "filter_by=in_stock%3Atrue&num_typos=1&per_page=5&query_by=name&use_cache=true", r.URL.RawQuery)
+		fmt.Fprint(w, `{"results": []}`)
+	})
+
+	body := &MultiSearchSearchesParameter{
+		Searches: []MultiSearchCollectionParameters{{Collection: "products", Q: String("shoe")}},
+	}
+	opts := &MultiSearchParameters{
+		QueryBy:  String("name"),
+		FilterBy: String("in_stock:true"),
+		NumTypos: String("1"),
+		PerPage:  Int(5),
+		UseCache: Bool(true),
+	}
+	_, err := client.Documents.MultiSearch(context.Background(), body, opts)
+	assert.NoError(t, err)
+}
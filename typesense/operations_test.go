@@ -16,6 +16,7 @@ func TestOperationsServiceService_Snapshot(t *testing.T) {
 	mux.HandleFunc("/operations/snapshot", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "POST", r.Method)
 		assert.NotEmpty(t, r.Header.Get(headerAPIKEy))
+		assert.Equal(t, "/tmp/typesense-data-snapshot", r.URL.Query().Get("snapshot_path"))
 		fmt.Fprint(w, `
 			{
 				"success": true
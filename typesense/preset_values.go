@@ -0,0 +1,105 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// PresetValue is the value of a preset: the parameters of a single search, or
+// the searches of a multi-search.
+type PresetValue interface {
+	*SearchParameters | *MultiSearchSearchesParameter
+}
+
+// SearchPreset is a preset with a value of type T.
+type SearchPreset[T PresetValue] struct {
+	Name  string
+	Value T
+}
+
+// UpsertSearchPreset creates or updates the preset with the name.
+func UpsertSearchPreset[T PresetValue](ctx context.Context, presets PresetsAPI, name string, value T) (*SearchPreset[T], error) {
+	res, err := presets.Upsert(ctx, name, &PresetUpsertSchema{Value: value})
+	if err != nil {
+		return nil, err
+	}
+	return toSearchPreset[T](res)
+}
+
+// GetSearchPreset returns the preset with the name. It fails if the preset
+// holds the other kind of value, e.g. a multi-search when T is
+// *SearchParameters.
+func GetSearchPreset[T PresetValue](ctx context.Context, presets PresetsAPI, name string) (*SearchPreset[T], error) {
+	res, err := presets.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return toSearchPreset[T](res)
+}
+
+func toSearchPreset[T PresetValue](p *Preset) (*SearchPreset[T], error) {
+	v, err := DecodePresetValue(p.Value)
+	if err != nil {
+		return nil, fmt.Errorf("preset %s: %w", p.Name, err)
+	}
+	value, ok := v.(T)
+	if !ok {
+		return nil, fmt.Errorf("preset %s: value is %T, not %T", p.Name, v, value)
+	}
+	return &SearchPreset[T]{Name: p.Name, Value: value}, nil
+}
+
+// DecodePresetValue converts the value of a preset, as returned by
+// PresetsService.Get and List, into a *MultiSearchSearchesParameter if it
+// has a searches key, or into *SearchParameters otherwise. Values of either
+// type are returned as they are.
+func DecodePresetValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case *SearchParameters, *MultiSearchSearchesParameter:
+		return value, nil
+	case nil:
+		return nil, errors.New("preset has no value")
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return nil, fmt.Errorf("preset value is not an object: %w", err)
+	}
+
+	var res interface{} = &SearchParameters{}
+	if _, ok := keys["searches"]; ok {
+		res = &MultiSearchSearchesParameter{}
+	}
+	if err := json.Unmarshal(b, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Merge returns the parameters a search with params using preset runs with:
+// the parameters of the preset, overridden by those set in params, as the
+// server does. The Preset parameter is cleared. Neither argument is modified.
+func Merge(preset, params *SearchParameters) *SearchParameters {
+	res := &SearchParameters{}
+	if preset != nil {
+		*res = *preset
+	}
+	if params != nil {
+		dst := reflect.ValueOf(res).Elem()
+		src := reflect.ValueOf(params).Elem()
+		for i := 0; i < src.NumField(); i++ {
+			if !src.Field(i).IsZero() {
+				dst.Field(i).Set(src.Field(i))
+			}
+		}
+	}
+	res.Preset = nil
+	return res
+}
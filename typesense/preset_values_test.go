@@ -0,0 +1,107 @@
+package typesense
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertSearchPreset(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/presets/listing_view", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"value": {"q": "*", "sort_by": "popularity:desc", "per_page": 24}}`, string(body))
+		fmt.Fprint(w, `{"name": "listing_view", "value": {"q": "*", "sort_by": "popularity:desc", "per_page": 24}}`)
+	})
+
+	got, err := UpsertSearchPreset(context.Background(), client.Presets, "listing_view", &SearchParameters{
+		Q:       "*",
+		SortBy:  String("popularity:desc"),
+		PerPage: Int(24),
+	})
+	require.NoError(t, err)
+
+	want := &SearchPreset[*SearchParameters]{
+		Name:  "listing_view",
+		Value: &SearchParameters{Q: "*", SortBy: String("popularity:desc"), PerPage: Int(24)},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestGetSearchPreset(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/presets/listing_view", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "listing_view", "value": {"q": "*", "query_by": "name", "filter_by": "in_stock:true"}}`)
+	})
+	mux.HandleFunc("/presets/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "dashboard", "value": {"searches": [{"collection": "products", "q": "*"}, {"collection": "brands", "q": "*"}]}}`)
+	})
+	ctx := context.Background()
+
+	single, err := GetSearchPreset[*SearchParameters](ctx, client.Presets, "listing_view")
+	require.NoError(t, err)
+	assert.Equal(t, &SearchParameters{Q: "*", QueryBy: "name", FilterBy: String("in_stock:true")}, single.Value)
+
+	multi, err := GetSearchPreset[*MultiSearchSearchesParameter](ctx, client.Presets, "dashboard")
+	require.NoError(t, err)
+	assert.Equal(t, &MultiSearchSearchesParameter{
+		Searches: []MultiSearchCollectionParameters{
+			{Collection: "products", Q: String("*")},
+			{Collection: "brands", Q: String("*")},
+		},
+	}, multi.Value)
+
+	_, err = GetSearchPreset[*SearchParameters](ctx, client.Presets, "dashboard")
+	assert.EqualError(t, err, "preset dashboard: value is *typesense.MultiSearchSearchesParameter, not *typesense.SearchParameters")
+}
+
+func TestDecodePresetValue(t *testing.T) {
+	v, err := DecodePresetValue(map[string]interface{}{"q": "shoe", "num_typos": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, &SearchParameters{Q: "shoe", NumTypos: String("1")}, v)
+
+	_, err = DecodePresetValue("listing_view")
+	assert.Error(t, err)
+
+	_, err = DecodePresetValue(nil)
+	assert.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	preset := &SearchParameters{
+		Q:        "*",
+		QueryBy:  "name",
+		SortBy:   String("popularity:desc"),
+		PerPage:  Int(24),
+		FilterBy: String("in_stock:true"),
+	}
+	params := &SearchParameters{
+		Q:       "shoe",
+		PerPage: Int(10),
+		Preset:  String("listing_view"),
+	}
+
+	got := Merge(preset, params)
+	want := &SearchParameters{
+		Q:        "shoe",
+		QueryBy:  "name",
+		SortBy:   String("popularity:desc"),
+		PerPage:  Int(10),
+		FilterBy: String("in_stock:true"),
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, 24, *preset.PerPage)
+	assert.Equal(t, "listing_view", *params.Preset)
+
+	assert.Equal(t, &SearchParameters{Q: "*"}, Merge(nil, &SearchParameters{Q: "*"}))
+}
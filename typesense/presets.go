@@ -48,6 +48,8 @@ func (s *PresetsService) Upsert(ctx context.Context, presetName string, body *Pr
 		res.Value = &SearchParameters{}
 	case *MultiSearchSearchesParameter:
 		res.Value = &MultiSearchSearchesParameter{}
+	case map[string]interface{}:
+		// raw values, e.g. loaded from a manifest, are decoded as they are
 	default:
 		return nil, fmt.Errorf("invalid type for body.Value")
 	}
@@ -113,3 +113,38 @@ func TestPresetService_Upsert(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func TestPresetService_UpsertRawValue(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	presetName := "listing_view"
+	u := fmt.Sprintf("/presets/%s", presetName)
+	mux.HandleFunc(u, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		fmt.Fprint(w, `
+			{
+				"name": "listing_view",
+				"value": {
+					"q": "*",
+					"sort_by": "popularity"
+				}
+			}`)
+	})
+
+	ctx := context.Background()
+	body := &PresetUpsertSchema{
+		Value: map[string]interface{}{
+			"q":       "*",
+			"sort_by": "popularity",
+		},
+	}
+	got, err := client.Presets.Upsert(ctx, presetName, body)
+	assert.NoError(t, err)
+
+	want := &Preset{
+		Name:  presetName,
+		Value: body.Value,
+	}
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,76 @@
+package typesense
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RaftState is the raft state of a node, as reported by MetaService.Debug
+// and MetaService.Status.
+type RaftState int
+
+// The values are those of braft's numeric state, as reported by /debug, and
+// the names those of /status. RaftNotReady is only reported by /status, before
+// the node's raft server has started, and has no braft number.
+const (
+	RaftNotReady      RaftState = -1
+	RaftUnknown       RaftState = 0
+	RaftLeader        RaftState = 1
+	RaftTransferring  RaftState = 2
+	RaftCandidate     RaftState = 3
+	RaftFollower      RaftState = 4
+	RaftError         RaftState = 5
+	RaftUninitialized RaftState = 6
+	RaftShutting      RaftState = 7
+	RaftShutdown      RaftState = 8
+)
+
+var raftStateNames = map[RaftState]string{
+	RaftNotReady:      "NOT_READY",
+	RaftUnknown:       "UNKNOWN",
+	RaftLeader:        "LEADER",
+	RaftTransferring:  "TRANSFERRING",
+	RaftCandidate:     "CANDIDATE",
+	RaftFollower:      "FOLLOWER",
+	RaftError:         "ERROR",
+	RaftUninitialized: "UNINITIALIZED",
+	RaftShutting:      "SHUTTING",
+	RaftShutdown:      "SHUTDOWN",
+}
+
+// String returns the name of the state as reported by /status, e.g. LEADER.
+func (s RaftState) String() string {
+	if name, ok := raftStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("RaftState(%d)", int(s))
+}
+
+// MarshalJSON encodes the state as its name.
+func (s RaftState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes both the numeric state of /debug and the name
+// reported by /status, with or without braft's STATE_ prefix. Unknown names
+// decode as RaftUnknown.
+func (s *RaftState) UnmarshalJSON(b []byte) error {
+	var n int
+	if err := json.Unmarshal(b, &n); err == nil {
+		*s = RaftState(n)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		return fmt.Errorf("typesense: invalid raft state %s", b)
+	}
+	*s = RaftUnknown
+	name = strings.TrimPrefix(name, "STATE_")
+	for state, stateName := range raftStateNames {
+		if stateName == name {
+			*s = state
+		}
+	}
+	return nil
+}
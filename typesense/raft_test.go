@@ -0,0 +1,55 @@
+package typesense
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaftState_JSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want RaftState
+	}{
+		{`1`, RaftLeader},
+		{`4`, RaftFollower},
+		{`8`, RaftShutdown},
+		{`"LEADER"`, RaftLeader},
+		{`"TRANSFERRING"`, RaftTransferring},
+		{`"CANDIDATE"`, RaftCandidate},
+		{`"FOLLOWER"`, RaftFollower},
+		{`"ERROR"`, RaftError},
+		{`"UNINITIALIZED"`, RaftUninitialized},
+		{`"SHUTTING"`, RaftShutting},
+		{`"SHUTDOWN"`, RaftShutdown},
+		{`"NOT_READY"`, RaftNotReady},
+		{`"STATE_TRANSFERRING"`, RaftTransferring},
+		{`"STATE_LEADER"`, RaftLeader},
+		{`"SOMETHING_NEW"`, RaftUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var got RaftState
+			require.NoError(t, json.Unmarshal([]byte(tt.in), &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	var state RaftState
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &state))
+}
+
+func TestRaftState_String(t *testing.T) {
+	for state, name := range raftStateNames {
+		assert.Equal(t, name, state.String())
+
+		b, err := json.Marshal(state)
+		require.NoError(t, err)
+		var got RaftState
+		require.NoError(t, json.Unmarshal(b, &got))
+		assert.Equal(t, state, got)
+	}
+	assert.Equal(t, "RaftState(42)", RaftState(42).String())
+}
@@ -145,7 +145,7 @@ func (s *RateLimitsService) Get(ctx context.Context, id int) (*RateLimitRule, er
 
 func (s *RateLimitsService) Update(ctx context.Context, id int, body *RateLimitRuleSchema) (*RateLimitResponse, error) {
 	u := fmt.Sprintf("/limits/%d", id)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequest("PUT", u, body)
 	if err != nil {
 		return nil, err
 	}
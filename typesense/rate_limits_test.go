@@ -2,6 +2,7 @@ package typesense
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -236,6 +237,52 @@ func TestRateLimitService_Get(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestRateLimitService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	id := 1
+	u := fmt.Sprintf("/limits/%d", id)
+	mux.HandleFunc(u, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.NotEmpty(t, r.Header.Get(headerAPIKEy))
+		var body RateLimitRuleSchema
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, BLOCK, body.Action)
+		fmt.Fprint(w, `
+			{
+				"message": "Rule updated successfully.",
+				"rule": {
+					"action": "block",
+					"api_keys": [
+						"abc"
+					],
+					"id": 1
+				}
+			}
+			`)
+	})
+
+	ctx := context.Background()
+	body := &RateLimitRuleSchema{
+		Action:  BLOCK,
+		ApiKeys: []string{"abc"},
+	}
+	got, err := client.RateLimits.Update(ctx, id, body)
+	assert.NoError(t, err)
+
+	want := &RateLimitResponse{
+		Message: "Rule updated successfully.",
+		Rule: &RateLimitRule{
+			ID:      1,
+			Action:  BLOCK,
+			ApiKeys: []string{"abc"},
+		},
+	}
+
+	assert.Equal(t, want, got)
+}
+
 func TestRateLimitService_Delete(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
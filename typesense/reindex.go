@@ -0,0 +1,488 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultReindexBatchSize = 1000
+	defaultReindexRetain    = 1
+)
+
+// DocumentSource produces the documents imported into a new collection
+// version. Next returns io.EOF once the source is exhausted.
+type DocumentSource interface {
+	Next(ctx context.Context) (map[string]interface{}, error)
+}
+
+type sliceSource struct {
+	docs []map[string]interface{}
+	pos  int
+}
+
+// SliceSource returns a DocumentSource that yields docs in order.
+func SliceSource(docs []map[string]interface{}) DocumentSource {
+	return &sliceSource{docs: docs}
+}
+
+func (s *sliceSource) Next(ctx context.Context) (map[string]interface{}, error) {
+	if s.pos >= len(s.docs) {
+		return nil, io.EOF
+	}
+	doc := s.docs[s.pos]
+	s.pos++
+	return doc, nil
+}
+
+type collectionSource struct {
+	client         *Client
+	collectionName string
+	opts           *ExportDocumentsParams
+	body           *io.PipeReader
+	dec            *json.Decoder
+}
+
+// CollectionSource returns a DocumentSource that yields the documents
+// exported from an existing collection (or alias). The export is streamed,
+// so the collection is never held in memory as a whole. Close stops the
+// export if the source is not read to the end; Reindexer.Run does so.
+func CollectionSource(client *Client, collectionName string, opts *ExportDocumentsParams) DocumentSource {
+	return &collectionSource{
+		client:         client,
+		collectionName: collectionName,
+		opts:           opts,
+	}
+}
+
+func (s *collectionSource) Next(ctx context.Context) (map[string]interface{}, error) {
+	if s.dec == nil {
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(s.client.Documents.ExportJSONL(ctx, s.collectionName, pw, s.opts))
+		}()
+		s.body = pr
+		s.dec = json.NewDecoder(pr)
+	}
+	var doc map[string]interface{}
+	if err := s.dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Close stops the export.
+func (s *collectionSource) Close() error {
+	if s.body == nil {
+		return nil
+	}
+	return s.body.Close()
+}
+
+// Reindexer rebuilds the collection behind an alias without downtime. Each
+// run creates a new versioned collection named `<alias>_v<N>`, imports the
+// documents, verifies them, copies curation data from the live collection
+// and finally flips the alias to the new version.
+type Reindexer struct {
+	client *Client
+
+	// Alias is the name of the alias that is flipped to the new version.
+	Alias string
+
+	// Schema is the schema of the new version. Its Name is ignored.
+	Schema *CollectionSchema
+
+	// BatchSize is the number of documents sent per import request.
+	// Default: 1000
+	BatchSize int
+
+	// Retain is the number of previous versions kept for rollback after the
+	// alias is flipped, counting the one the alias pointed to before, which
+	// is always kept. Older versions are deleted, as are versions newer than
+	// that one, which never served traffic.
+	// Default: 1
+	Retain int
+
+	// CopySynonyms copies the synonyms of the live collection to the new
+	// version before the alias is flipped.
+	CopySynonyms bool
+
+	// CopyOverrides copies the overrides of the live collection to the new
+	// version before the alias is flipped.
+	CopyOverrides bool
+
+	// SmokeQueries are searched against the new version before the alias is
+	// flipped. Each of them must return at least one hit.
+	SmokeQueries []*SearchParameters
+}
+
+// ReindexResult describes the outcome of a Reindexer run.
+type ReindexResult struct {
+	// Collection is the name of the new version the alias points to.
+	Collection string
+
+	// Previous is the name of the collection the alias pointed to before the
+	// flip, empty if the alias did not exist.
+	Previous string
+
+	// NumDocuments is the number of documents imported into the new version.
+	NumDocuments int
+
+	// Deleted lists the old versions removed by the retention policy.
+	Deleted []string
+}
+
+// ReindexError is returned when documents of a new version fail to import.
+type ReindexError struct {
+	Collection string
+	Failed     []*ImportDocumentResponse
+}
+
+func (e *ReindexError) Error() string {
+	return fmt.Sprintf("reindex %s: %d documents failed to import", e.Collection, len(e.Failed))
+}
+
+func NewReindexer(client *Client, alias string, schema *CollectionSchema) *Reindexer {
+	return &Reindexer{
+		client:    client,
+		Alias:     alias,
+		Schema:    schema,
+		BatchSize: defaultReindexBatchSize,
+		Retain:    defaultReindexRetain,
+	}
+}
+
+// Run imports all documents from src into a new version of the collection
+// and flips the alias to it once verification succeeds. If any step before
+// the flip fails, the new version is deleted and the alias is left untouched.
+// If src is an io.Closer, it is closed when Run returns.
+func (r *Reindexer) Run(ctx context.Context, src DocumentSource) (*ReindexResult, error) {
+	if c, ok := src.(io.Closer); ok {
+		defer c.Close()
+	}
+	if r.Alias == "" {
+		return nil, errors.New("alias is required")
+	}
+	if r.Schema == nil {
+		return nil, errors.New("schema is required")
+	}
+
+	previous, err := r.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := r.Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	next := 1
+	if len(versions) > 0 {
+		next = versions[len(versions)-1].Number + 1
+	}
+	name := r.versionName(next)
+
+	schema := *r.Schema
+	schema.Name = name
+	if _, err := r.client.Collections.Create(ctx, &schema); err != nil {
+		return nil, err
+	}
+
+	res := &ReindexResult{Collection: name, Previous: previous}
+	if err := r.build(ctx, name, previous, src, res); err != nil {
+		r.client.Collections.Delete(ctx, name)
+		return nil, err
+	}
+
+	_, err = r.client.Aliases.Upsert(ctx, r.Alias, &CollectionAliasSchema{CollectionName: name})
+	if err != nil {
+		r.client.Collections.Delete(ctx, name)
+		return nil, err
+	}
+
+	res.Deleted, err = r.prune(ctx, name, previous)
+	if err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// Rollback flips the alias back to the newest version older than the one
+// it currently points to and returns its name.
+func (r *Reindexer) Rollback(ctx context.Context) (string, error) {
+	current, err := r.current(ctx)
+	if err != nil {
+		return "", err
+	}
+	versions, err := r.Versions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	target := ""
+	for _, v := range versions {
+		if v.Name == current {
+			break
+		}
+		target = v.Name
+	}
+	if target == "" {
+		return "", fmt.Errorf("no previous version of %s to roll back to", r.Alias)
+	}
+
+	_, err = r.client.Aliases.Upsert(ctx, r.Alias, &CollectionAliasSchema{CollectionName: target})
+	if err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+// CollectionVersion is a versioned collection managed by a Reindexer.
+type CollectionVersion struct {
+	Name   string
+	Number int
+}
+
+// Versions lists the versioned collections of the alias, oldest first.
+func (r *Reindexer) Versions(ctx context.Context) ([]*CollectionVersion, error) {
+	collections, err := r.client.Collections.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := r.Alias + "_v"
+	var versions []*CollectionVersion
+	for _, c := range collections {
+		if !strings.HasPrefix(c.Name, prefix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(c.Name, prefix))
+		if err != nil || n < 1 {
+			continue
+		}
+		versions = append(versions, &CollectionVersion{Name: c.Name, Number: n})
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Number < versions[j].Number
+	})
+	return versions, nil
+}
+
+func (r *Reindexer) versionName(n int) string {
+	return fmt.Sprintf("%s_v%d", r.Alias, n)
+}
+
+// current returns the collection the alias points to, or an empty string if
+// the alias does not exist yet.
+func (r *Reindexer) current(ctx context.Context) (string, error) {
+	alias, err := r.client.Aliases.Get(ctx, r.Alias)
+	if err != nil {
+		var apiErr *ApiError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	return alias.CollectionName, nil
+}
+
+func (r *Reindexer) build(ctx context.Context, name, previous string, src DocumentSource, res *ReindexResult) error {
+	n, err := r.importAll(ctx, name, src)
+	if err != nil {
+		return err
+	}
+	res.NumDocuments = n
+
+	collection, err := r.client.Collections.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if collection.NumDocuments == nil || *collection.NumDocuments != int64(n) {
+		got := int64(0)
+		if collection.NumDocuments != nil {
+			got = *collection.NumDocuments
+		}
+		return fmt.Errorf("reindex %s: expected %d documents, found %d", name, n, got)
+	}
+
+	for _, params := range r.SmokeQueries {
+		result, err := r.client.Documents.Search(ctx, name, params)
+		if err != nil {
+			return err
+		}
+		if result.Found == nil || *result.Found == 0 {
+			return fmt.Errorf("reindex %s: smoke query %q returned no hits", name, params.Q)
+		}
+	}
+
+	if previous == "" {
+		return nil
+	}
+	if r.CopySynonyms {
+		if err := r.copySynonyms(ctx, previous, name); err != nil {
+			return err
+		}
+	}
+	if r.CopyOverrides {
+		if err := r.copyOverrides(ctx, previous, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reindexer) importAll(ctx context.Context, name string, src DocumentSource) (int, error) {
+	batchSize := r.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultReindexBatchSize
+	}
+
+	total := 0
+	batch := make([]map[string]interface{}, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		res, err := r.client.Documents.Import(ctx, name, batch, nil)
+		if err != nil {
+			return err
+		}
+		var failed []*ImportDocumentResponse
+		for _, line := range res {
+			if !line.Success {
+				failed = append(failed, line)
+			}
+		}
+		if len(failed) > 0 {
+			return &ReindexError{Collection: name, Failed: failed}
+		}
+		total += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		doc, err := src.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		batch = append(batch, doc)
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *Reindexer) copySynonyms(ctx context.Context, from, to string) error {
+	res, err := r.client.Synonyms.List(ctx, from)
+	if err != nil {
+		return err
+	}
+	for _, s := range res.Synonyms {
+		if s.Id == nil {
+			continue
+		}
+		body := &SearchSynonymSchema{Root: s.Root, Synonyms: s.Synonyms}
+		if _, err := r.client.Synonyms.Upsert(ctx, to, *s.Id, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reindexer) copyOverrides(ctx context.Context, from, to string) error {
+	res, err := r.client.Overrides.List(ctx, from)
+	if err != nil {
+		return err
+	}
+	for _, o := range res.Overrides {
+		if o.Id == nil {
+			continue
+		}
+		body := &SearchOverrideSchema{
+			FilterBy:            o.FilterBy,
+			RemoveMatchedTokens: o.RemoveMatchedTokens,
+			Rule:                o.Rule,
+		}
+		if len(o.Excludes) > 0 {
+			excludes := make([]SearchOverrideExclude, len(o.Excludes))
+			for i, e := range o.Excludes {
+				excludes[i] = *e
+			}
+			body.Excludes = &excludes
+		}
+		if len(o.Includes) > 0 {
+			includes := make([]SearchOverrideInclude, len(o.Includes))
+			for i, in := range o.Includes {
+				includes[i] = *in
+			}
+			body.Includes = &includes
+		}
+		if _, err := r.client.Overrides.Upsert(ctx, to, *o.Id, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prune deletes versions older than the live one beyond the retention limit.
+// previous, the version live before, is kept regardless of the limit, and the
+// versions between it and the live one are deleted: they never served traffic
+// and must not be rolled back to.
+func (r *Reindexer) prune(ctx context.Context, live, previous string) ([]string, error) {
+	versions, err := r.Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var older []*CollectionVersion
+	served := -1
+	for _, v := range versions {
+		if v.Name == live {
+			break
+		}
+		if v.Name == previous {
+			served = len(older)
+		}
+		older = append(older, v)
+	}
+
+	retain := r.Retain
+	if retain < 0 {
+		retain = 0
+	}
+	if served < 0 {
+		// the alias did not point to a version: any of them may have served
+		served = len(older) - 1
+	} else if retain < 1 {
+		retain = 1
+	}
+
+	var deleted []string
+	for i, v := range older {
+		if i > served-retain && i <= served {
+			continue
+		}
+		if _, err := r.client.Collections.Delete(ctx, v.Name); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, v.Name)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,270 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReindexer_Run(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var (
+		deleted       []string
+		aliasTarget   string
+		imported      int
+		synonymCopied bool
+		overrideCopy  bool
+	)
+
+	mux.HandleFunc("/aliases/products", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"name": "products", "collection_name": "products_v2"}`)
+		case "PUT":
+			var body CollectionAliasSchema
+			json.NewDecoder(r.Body).Decode(&body)
+			aliasTarget = body.CollectionName
+			fmt.Fprintf(w, `{"name": "products", "collection_name": %q}`, body.CollectionName)
+		}
+	})
+	mux.HandleFunc("/collections", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[
+				{"name": "products_v1", "fields": []},
+				{"name": "products_v2", "fields": []},
+				{"name": "products_v3", "fields": []},
+				{"name": "products_vx", "fields": []},
+				{"name": "companies", "fields": []}
+			]`)
+		case "POST":
+			var body CollectionSchema
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "products_v4", body.Name)
+			fmt.Fprint(w, `{"name": "products_v4", "fields": []}`)
+		}
+	})
+	mux.HandleFunc("/collections/products_v4/documents/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+		assert.LessOrEqual(t, len(lines), 2)
+		for range lines {
+			imported++
+			fmt.Fprintln(w, `{"success": true}`)
+		}
+	})
+	mux.HandleFunc("/collections/products_v4", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprintf(w, `{"name": "products_v4", "fields": [], "num_documents": %d}`, imported)
+	})
+	mux.HandleFunc("/collections/products_v4/documents/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "shoe", r.URL.Query().Get("q"))
+		fmt.Fprint(w, `{"found": 1, "hits": []}`)
+	})
+	mux.HandleFunc("/collections/products_v2/synonyms", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"synonyms": [{"id": "coat-synonyms", "synonyms": ["blazer", "coat", "jacket"]}]}`)
+	})
+	mux.HandleFunc("/collections/products_v4/synonyms/coat-synonyms", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		synonymCopied = true
+		fmt.Fprint(w, `{"id": "coat-synonyms", "synonyms": ["blazer", "coat", "jacket"]}`)
+	})
+	mux.HandleFunc("/collections/products_v2/overrides", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"overrides": [{"id": "customize-apple", "rule": {"query": "apple", "match": "exact"}, "includes": [{"id": "422", "position": 1}]}]}`)
+	})
+	mux.HandleFunc("/collections/products_v4/overrides/customize-apple", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		var body SearchOverrideSchema
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, &[]SearchOverrideInclude{{Id: "422", Position: 1}}, body.Includes)
+		overrideCopy = true
+		fmt.Fprint(w, `{"id": "customize-apple", "rule": {"query": "apple", "match": "exact"}}`)
+	})
+	for _, name := range []string{"products_v1", "products_v3"} {
+		name := name
+		mux.HandleFunc("/collections/"+name, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "DELETE", r.Method)
+			deleted = append(deleted, name)
+			fmt.Fprintf(w, `{"name": %q, "fields": []}`, name)
+		})
+	}
+
+	r := NewReindexer(client, "products", &CollectionSchema{
+		Fields: []*Field{{Name: "name", Type: "string"}},
+	})
+	r.BatchSize = 2
+	r.Retain = 1
+	r.CopySynonyms = true
+	r.CopyOverrides = true
+	r.SmokeQueries = []*SearchParameters{{Q: "shoe", QueryBy: "name"}}
+
+	src := SliceSource([]map[string]interface{}{
+		{"id": "1", "name": "shoe"},
+		{"id": "2", "name": "coat"},
+		{"id": "3", "name": "jacket"},
+	})
+
+	ctx := context.Background()
+	got, err := r.Run(ctx, src)
+	require.NoError(t, err)
+
+	want := &ReindexResult{
+		Collection:   "products_v4",
+		Previous:     "products_v2",
+		NumDocuments: 3,
+		Deleted:      []string{"products_v1", "products_v3"},
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, "products_v4", aliasTarget)
+	assert.True(t, synonymCopied)
+	assert.True(t, overrideCopy)
+	// products_v3 never served traffic; products_v2 is kept for rollback
+	assert.Equal(t, []string{"products_v1", "products_v3"}, deleted)
+}
+
+func TestReindexer_RunImportFailure(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var dropped, flipped bool
+
+	mux.HandleFunc("/aliases/products", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			flipped = true
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "Not Found"}`)
+	})
+	mux.HandleFunc("/collections", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[]`)
+		case "POST":
+			fmt.Fprint(w, `{"name": "products_v1", "fields": []}`)
+		}
+	})
+	mux.HandleFunc("/collections/products_v1/documents/import", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true}`)
+		fmt.Fprintln(w, `{"success": false, "error": "Field price must be a float.", "code": 400}`)
+	})
+	mux.HandleFunc("/collections/products_v1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		dropped = true
+		fmt.Fprint(w, `{"name": "products_v1", "fields": []}`)
+	})
+
+	r := NewReindexer(client, "products", &CollectionSchema{
+		Fields: []*Field{{Name: "price", Type: "float"}},
+	})
+	src := SliceSource([]map[string]interface{}{
+		{"id": "1", "price": 1.5},
+		{"id": "2", "price": "free"},
+	})
+
+	ctx := context.Background()
+	_, err := r.Run(ctx, src)
+
+	var reindexErr *ReindexError
+	require.ErrorAs(t, err, &reindexErr)
+	assert.Equal(t, "products_v1", reindexErr.Collection)
+	assert.Len(t, reindexErr.Failed, 1)
+	assert.True(t, dropped)
+	assert.False(t, flipped)
+}
+
+func TestReindexer_Rollback(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var aliasTarget string
+	mux.HandleFunc("/aliases/products", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"name": "products", "collection_name": "products_v3"}`)
+		case "PUT":
+			var body CollectionAliasSchema
+			json.NewDecoder(r.Body).Decode(&body)
+			aliasTarget = body.CollectionName
+			fmt.Fprintf(w, `{"name": "products", "collection_name": %q}`, body.CollectionName)
+		}
+	})
+	mux.HandleFunc("/collections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name": "products_v3", "fields": []}, {"name": "products_v2", "fields": []}]`)
+	})
+
+	r := NewReindexer(client, "products", nil)
+
+	ctx := context.Background()
+	got, err := r.Rollback(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "products_v2", got)
+	assert.Equal(t, "products_v2", aliasTarget)
+}
+
+func TestReindexer_DefaultRetain(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/collections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name": "products_v1", "fields": []}, {"name": "products_v2", "fields": []}, {"name": "products_v3", "fields": []}]`)
+	})
+	var deleted []string
+	mux.HandleFunc("/collections/products_v1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		deleted = append(deleted, "products_v1")
+		fmt.Fprint(w, `{"name": "products_v1", "fields": []}`)
+	})
+
+	r := NewReindexer(client, "products", nil)
+	assert.Equal(t, 1, r.Retain)
+
+	got, err := r.prune(context.Background(), "products_v3", "products_v2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"products_v1"}, got)
+	assert.Equal(t, []string{"products_v1"}, deleted)
+
+	// the previously live version is kept even without retention
+	r.Retain = 0
+	got, err = r.prune(context.Background(), "products_v3", "products_v2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"products_v1"}, got)
+}
+
+func TestCollectionSource(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	read := make(chan struct{})
+	mux.HandleFunc("/collections/products/documents/export", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"id": "1", "name": "shoe"}`)
+		w.(http.Flusher).Flush()
+		// the first document is decoded before the export is complete
+		<-read
+		fmt.Fprintln(w, `{"id": "2", "name": "coat"}`)
+	})
+
+	ctx := context.Background()
+	src := CollectionSource(client, "products", nil)
+	doc, err := src.Next(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "1", "name": "shoe"}, doc)
+	close(read)
+
+	doc, err = src.Next(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "2", "name": "coat"}, doc)
+
+	_, err = src.Next(ctx)
+	assert.Equal(t, io.EOF, err)
+	assert.NoError(t, src.(io.Closer).Close())
+}
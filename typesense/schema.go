@@ -0,0 +1,213 @@
+package typesense
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldType is the data type of a collection field.
+type FieldType string
+
+const (
+	FieldTypeString        FieldType = "string"
+	FieldTypeStringArray   FieldType = "string[]"
+	FieldTypeInt32         FieldType = "int32"
+	FieldTypeInt32Array    FieldType = "int32[]"
+	FieldTypeInt64         FieldType = "int64"
+	FieldTypeInt64Array    FieldType = "int64[]"
+	FieldTypeFloat         FieldType = "float"
+	FieldTypeFloatArray    FieldType = "float[]" // also used for vectors, see Field.NumDim
+	FieldTypeBool          FieldType = "bool"
+	FieldTypeBoolArray     FieldType = "bool[]"
+	FieldTypeGeopoint      FieldType = "geopoint"
+	FieldTypeGeopointArray FieldType = "geopoint[]"
+	FieldTypeObject        FieldType = "object"
+	FieldTypeObjectArray   FieldType = "object[]"
+	FieldTypeImage         FieldType = "image"
+
+	// FieldTypeStringAuto indexes values as string or string[] depending on
+	// the first value seen.
+	FieldTypeStringAuto FieldType = "string*"
+
+	// FieldTypeAuto infers the type from the first value seen.
+	FieldTypeAuto FieldType = "auto"
+)
+
+var fieldTypes = map[FieldType]bool{
+	FieldTypeString: true, FieldTypeStringArray: true,
+	FieldTypeInt32: true, FieldTypeInt32Array: true,
+	FieldTypeInt64: true, FieldTypeInt64Array: true,
+	FieldTypeFloat: true, FieldTypeFloatArray: true,
+	FieldTypeBool: true, FieldTypeBoolArray: true,
+	FieldTypeGeopoint: true, FieldTypeGeopointArray: true,
+	FieldTypeObject: true, FieldTypeObjectArray: true,
+	FieldTypeImage: true, FieldTypeStringAuto: true, FieldTypeAuto: true,
+}
+
+// Valid reports whether t is a type known to Typesense.
+func (t FieldType) Valid() bool {
+	return fieldTypes[t]
+}
+
+// IsArray reports whether t holds a list of values.
+func (t FieldType) IsArray() bool {
+	return strings.HasSuffix(string(t), "[]")
+}
+
+// IsNumeric reports whether t is int32, int64 or float.
+func (t FieldType) IsNumeric() bool {
+	return t == FieldTypeInt32 || t == FieldTypeInt64 || t == FieldTypeFloat
+}
+
+// IsObject reports whether t is object or object[].
+func (t FieldType) IsObject() bool {
+	return t == FieldTypeObject || t == FieldTypeObjectArray
+}
+
+// ValidationError describes an invalid value at a field path.
+type ValidationError struct {
+	// Field is the path of the invalid field, e.g. `fields[2].num_dim` or
+	// `address.city`. It is empty for errors about the value as a whole.
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors is a list of validation errors.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationErrors) add(field, format string, args ...interface{}) {
+	*e = append(*e, &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (e ValidationErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Validate checks the schema for mistakes the server would reject, so they
+// are caught before a round-trip. It returns ValidationErrors listing every
+// problem found.
+func (s *CollectionSchema) Validate() error {
+	var errs ValidationErrors
+	if s.Name == "" {
+		errs.add("name", "must not be empty")
+	}
+
+	byName := make(map[string]*Field, len(s.Fields))
+	for i, f := range s.Fields {
+		path := fmt.Sprintf("fields[%d]", i)
+		if f == nil {
+			errs.add(path, "must not be nil")
+			continue
+		}
+		s.validateField(&errs, path, f)
+
+		if f.Name == "" {
+			continue
+		}
+		if _, ok := byName[f.Name]; ok {
+			errs.add(path+".name", "duplicate field %q", f.Name)
+		}
+		byName[f.Name] = f
+	}
+
+	for i, f := range s.Fields {
+		if f == nil || f.Embed == nil {
+			continue
+		}
+		f.Embed.validate(&errs, fmt.Sprintf("fields[%d].embed", i))
+
+		path := fmt.Sprintf("fields[%d].embed.from", i)
+		if len(f.Embed.From) == 0 {
+			errs.add(path, "must name at least one field")
+		}
+		for _, name := range f.Embed.From {
+			from, ok := byName[name]
+			switch {
+			case !ok:
+				errs.add(path, "unknown field %q", name)
+			case from.Type != FieldTypeString && from.Type != FieldTypeStringArray && from.Type != FieldTypeImage:
+				errs.add(path, "field %q must be of type string, string[] or image, not %s", name, from.Type)
+			}
+		}
+	}
+
+	if s.DefaultSortingField != nil && *s.DefaultSortingField != "" {
+		name := *s.DefaultSortingField
+		f, ok := byName[name]
+		switch {
+		case !ok:
+			errs.add("default_sorting_field", "unknown field %q", name)
+		case !f.Type.IsNumeric():
+			errs.add("default_sorting_field", "field %q must be of type int32, int64 or float, not %s", name, f.Type)
+		case f.Optional != nil && *f.Optional:
+			errs.add("default_sorting_field", "field %q must not be optional", name)
+		}
+	}
+	return errs.err()
+}
+
+func (s *CollectionSchema) validateField(errs *ValidationErrors, path string, f *Field) {
+	if f.Name == "" {
+		errs.add(path+".name", "must not be empty")
+	}
+	if f.Drop != nil && *f.Drop {
+		errs.add(path+".drop", "can only be used when updating a collection")
+	}
+	if !f.Type.Valid() {
+		errs.add(path+".type", "unknown type %q", f.Type)
+		return
+	}
+
+	isVector := f.NumDim != nil || f.Embed != nil
+	if f.NumDim != nil {
+		if f.Type != FieldTypeFloatArray {
+			errs.add(path+".num_dim", "requires type float[], not %s", f.Type)
+		}
+		if *f.NumDim <= 0 {
+			errs.add(path+".num_dim", "must be positive")
+		}
+	}
+	if f.Embed != nil && f.Type != FieldTypeFloatArray {
+		errs.add(path+".embed", "requires type float[], not %s", f.Type)
+	}
+
+	if f.Type.IsObject() && (s.EnableNestedFields == nil || !*s.EnableNestedFields) {
+		errs.add(path+".type", "%s requires enable_nested_fields", f.Type)
+	}
+
+	if f.Facet != nil && *f.Facet {
+		switch {
+		case f.Type == FieldTypeGeopoint || f.Type == FieldTypeGeopointArray || f.Type == FieldTypeImage || f.Type.IsObject():
+			errs.add(path+".facet", "is not supported for type %s", f.Type)
+		case isVector:
+			errs.add(path+".facet", "is not supported for vector fields")
+		}
+	}
+	if f.Sort != nil && *f.Sort && (f.Type.IsArray() || f.Type.IsObject() || f.Type == FieldTypeImage) {
+		errs.add(path+".sort", "is not supported for type %s", f.Type)
+	}
+
+	if f.Reference != nil {
+		if collection, field, ok := strings.Cut(*f.Reference, "."); !ok || collection == "" || field == "" {
+			errs.add(path+".reference", "must be of the form collection.field, not %q", *f.Reference)
+		}
+	}
+}
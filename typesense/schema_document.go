@@ -0,0 +1,314 @@
+package typesense
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ValidateDocument checks doc against the schema the way the server does when
+// importing with dirty_values=reject: required fields must be present and
+// every value must match the type of its field. doc is a map or a struct
+// that encodes to a JSON object. It returns ValidationErrors listing every
+// problem found, with the path of the offending value such as `tags[2]` or
+// `address.city`.
+func (s *CollectionSchema) ValidateDocument(doc interface{}) error {
+	_, err := s.CoerceDocument(doc, Reject)
+	return err
+}
+
+// CoerceDocument applies the dirty values semantics of dirty locally and
+// returns the document as the server would index it: with CoerceOrReject and
+// CoerceOrDrop, values of the wrong type are converted when possible, e.g.
+// "42" to 42 for an int32 field; with Drop and CoerceOrDrop, invalid values of
+// optional fields are removed instead of failing the document. An empty dirty
+// defaults to CoerceOrReject, like the server.
+//
+// Values of fields nested in arrays of objects, such as `addresses.city`, are
+// checked but never coerced or dropped.
+func (s *CollectionSchema) CoerceDocument(doc interface{}, dirty DirtyValuesOptions) (map[string]interface{}, error) {
+	var coerce, drop bool
+	switch dirty {
+	case Reject:
+	case Drop:
+		drop = true
+	case CoerceOrReject, "":
+		coerce = true
+	case CoerceOrDrop:
+		coerce, drop = true, true
+	default:
+		return nil, fmt.Errorf("typesense: unknown dirty values option %q", dirty)
+	}
+
+	d, err := toDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs ValidationErrors
+	if id, ok := d["id"]; ok {
+		if _, isString := id.(string); !isString {
+			errs.add("id", "must be a string, not %s", jsonType(id))
+		}
+	}
+
+	nested := s.EnableNestedFields != nil && *s.EnableNestedFields
+	for _, f := range s.Fields {
+		if f == nil || f.Embed != nil || f.Index != nil && !*f.Index || f.Type == FieldTypeAuto || isFieldPattern(f.Name) {
+			continue
+		}
+		optional := f.Optional != nil && *f.Optional
+
+		v, found, parent, key := lookupDocumentField(d, f.Name, nested)
+		if !found || v == nil {
+			if !optional {
+				errs.add(f.Name, "is required")
+			}
+			continue
+		}
+
+		var fieldErrs ValidationErrors
+		coerced := checkValue(&fieldErrs, f.Name, f.Type, v, coerce && parent != nil)
+		if len(fieldErrs) == 0 && f.NumDim != nil {
+			if vec, ok := coerced.([]interface{}); ok && len(vec) != *f.NumDim {
+				fieldErrs.add(f.Name, "must have %d dimensions, not %d", *f.NumDim, len(vec))
+			}
+		}
+
+		switch {
+		case len(fieldErrs) == 0:
+			if parent != nil {
+				parent[key] = coerced
+			}
+		case drop && optional && parent != nil:
+			delete(parent, key)
+		default:
+			errs = append(errs, fieldErrs...)
+		}
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// toDocument converts doc to a JSON object, keeping numbers as json.Number so
+// integers and floats can be told apart.
+func toDocument(doc interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var d map[string]interface{}
+	if err := dec.Decode(&d); err != nil {
+		return nil, fmt.Errorf("typesense: document must be a JSON object: %w", err)
+	}
+	if d == nil {
+		return nil, fmt.Errorf("typesense: document must be a JSON object")
+	}
+	return d, nil
+}
+
+// isFieldPattern reports whether name is a regular expression matching
+// several fields, such as `.*_facet`.
+func isFieldPattern(name string) bool {
+	return strings.ContainsAny(name, "*+?^$()[]{}|\\")
+}
+
+// lookupDocumentField returns the value of the field name in d, and the
+// object holding it with its key there. With nested fields, `address.city` is
+// looked up in the address object; through arrays of objects, the values are
+// collected into a list and no parent is returned.
+func lookupDocumentField(d map[string]interface{}, name string, nested bool) (interface{}, bool, map[string]interface{}, string) {
+	if v, ok := d[name]; ok {
+		return v, true, d, name
+	}
+	head, rest, ok := strings.Cut(name, ".")
+	if !nested || !ok {
+		return nil, false, nil, ""
+	}
+
+	switch obj := d[head].(type) {
+	case map[string]interface{}:
+		return lookupDocumentField(obj, rest, nested)
+	case []interface{}:
+		var values []interface{}
+		for _, elem := range obj {
+			m, ok := elem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if v, ok, _, _ := lookupDocumentField(m, rest, nested); ok {
+				if list, ok := v.([]interface{}); ok {
+					values = append(values, list...)
+				} else {
+					values = append(values, v)
+				}
+			}
+		}
+		return values, values != nil, nil, ""
+	}
+	return nil, false, nil, ""
+}
+
+// checkValue checks that v is of type t and returns it, converted to t if
+// coerce is set and v has another type.
+func checkValue(errs *ValidationErrors, path string, t FieldType, v interface{}, coerce bool) interface{} {
+	if t.IsArray() {
+		list, ok := v.([]interface{})
+		if !ok {
+			errs.add(path, "must be an array of %s, not %s", strings.TrimSuffix(string(t), "[]"), jsonType(v))
+			return v
+		}
+		elemType := FieldType(strings.TrimSuffix(string(t), "[]"))
+		res := make([]interface{}, len(list))
+		for i, elem := range list {
+			res[i] = checkValue(errs, fmt.Sprintf("%s[%d]", path, i), elemType, elem, coerce)
+		}
+		return res
+	}
+
+	switch t {
+	case FieldTypeString, FieldTypeImage:
+		switch x := v.(type) {
+		case string:
+			return x
+		case json.Number:
+			if coerce {
+				return x.String()
+			}
+		case bool:
+			if coerce {
+				return strconv.FormatBool(x)
+			}
+		}
+		errs.add(path, "must be a string, not %s", jsonType(v))
+
+	case FieldTypeStringAuto:
+		if _, ok := v.([]interface{}); ok {
+			return checkValue(errs, path, FieldTypeStringArray, v, coerce)
+		}
+		return checkValue(errs, path, FieldTypeString, v, coerce)
+
+	case FieldTypeInt32, FieldTypeInt64:
+		n, ok := toNumber(v, coerce)
+		if !ok {
+			errs.add(path, "must be an integer, not %s", jsonType(v))
+			return v
+		}
+		if n != math.Trunc(n) {
+			if !coerce {
+				errs.add(path, "must be an integer, not %v", v)
+				return v
+			}
+			n = math.Trunc(n)
+		}
+		if t == FieldTypeInt32 && (n < math.MinInt32 || n > math.MaxInt32) {
+			errs.add(path, "%v is out of range for int32", v)
+			return v
+		}
+		if x, isNumber := v.(json.Number); isNumber {
+			if i, err := x.Int64(); err == nil {
+				return i
+			}
+		}
+		return int64(n)
+
+	case FieldTypeFloat:
+		n, ok := toNumber(v, coerce)
+		if !ok {
+			errs.add(path, "must be a number, not %s", jsonType(v))
+			return v
+		}
+		return n
+
+	case FieldTypeBool:
+		switch x := v.(type) {
+		case bool:
+			return x
+		case string:
+			if b, err := strconv.ParseBool(x); coerce && err == nil {
+				return b
+			}
+		case json.Number:
+			if coerce && (x == "0" || x == "1") {
+				return x == "1"
+			}
+		}
+		errs.add(path, "must be a boolean, not %s", jsonType(v))
+
+	case FieldTypeGeopoint:
+		pair, ok := v.([]interface{})
+		if !ok || len(pair) != 2 {
+			errs.add(path, "must be a [lat, lng] pair")
+			return v
+		}
+		lat, latOK := toNumber(pair[0], false)
+		lng, lngOK := toNumber(pair[1], false)
+		switch {
+		case !latOK || !lngOK:
+			errs.add(path, "must be a [lat, lng] pair of numbers")
+		case lat < -90 || lat > 90:
+			errs.add(path, "latitude %v is out of range", lat)
+		case lng < -180 || lng > 180:
+			errs.add(path, "longitude %v is out of range", lng)
+		default:
+			return []interface{}{lat, lng}
+		}
+
+	case FieldTypeObject:
+		if _, ok := v.(map[string]interface{}); !ok {
+			errs.add(path, "must be an object, not %s", jsonType(v))
+		}
+	}
+	return v
+}
+
+// toNumber returns v as a float64. Strings and booleans are converted if
+// coerce is set.
+func toNumber(v interface{}, coerce bool) (float64, bool) {
+	switch x := v.(type) {
+	case json.Number:
+		n, err := x.Float64()
+		return n, err == nil
+	case float64:
+		return x, true
+	case string:
+		if coerce {
+			n, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+			return n, err == nil
+		}
+	case bool:
+		if coerce {
+			if x {
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case json.Number, float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
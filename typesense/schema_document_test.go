@@ -0,0 +1,145 @@
+package typesense
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var productsSchema = &CollectionSchema{
+	Name:               "products",
+	EnableNestedFields: Bool(true),
+	Fields: []*Field{
+		{Name: "name", Type: FieldTypeString},
+		{Name: "price", Type: FieldTypeFloat},
+		{Name: "stock", Type: FieldTypeInt32, Optional: Bool(true)},
+		{Name: "tags", Type: FieldTypeStringArray, Optional: Bool(true)},
+		{Name: "in_sale", Type: FieldTypeBool, Optional: Bool(true)},
+		{Name: "location", Type: FieldTypeGeopoint, Optional: Bool(true)},
+		{Name: "brand.country", Type: FieldTypeString, Optional: Bool(true)},
+		{Name: "variants.sku", Type: FieldTypeStringArray, Optional: Bool(true)},
+		{Name: "vec", Type: FieldTypeFloatArray, NumDim: Int(3), Optional: Bool(true)},
+		{Name: ".*_facet", Type: FieldTypeAuto},
+	},
+}
+
+func TestCollectionSchema_ValidateDocument(t *testing.T) {
+	type product struct {
+		Name  string  `json:"name"`
+		Price float64 `json:"price"`
+	}
+	require.NoError(t, productsSchema.ValidateDocument(&product{Name: "shoe", Price: 9.5}))
+	require.NoError(t, productsSchema.ValidateDocument(map[string]interface{}{
+		"id":       "p1",
+		"name":     "shoe",
+		"price":    10,
+		"stock":    nil,
+		"location": []float64{48.85, 2.35},
+		"brand":    map[string]interface{}{"country": "FR"},
+		"variants": []interface{}{map[string]interface{}{"sku": "a"}, map[string]interface{}{"sku": "b"}},
+		"vec":      []float64{0.1, 0.2, 0.3},
+	}))
+
+	tests := []struct {
+		name string
+		doc  map[string]interface{}
+		want []string
+	}{
+		{
+			name: "required",
+			doc:  map[string]interface{}{"id": 1, "price": nil},
+			want: []string{"id: must be a string, not number", "name: is required", "price: is required"},
+		},
+		{
+			name: "types",
+			doc: map[string]interface{}{
+				"name":    42,
+				"price":   "9.5",
+				"stock":   1.5,
+				"tags":    []interface{}{"a", 2},
+				"in_sale": "yes",
+			},
+			want: []string{
+				"name: must be a string, not number",
+				"price: must be a number, not string",
+				"stock: must be an integer, not 1.5",
+				"tags[1]: must be a string, not number",
+				"in_sale: must be a boolean, not string",
+			},
+		},
+		{
+			name: "geopoint and vector",
+			doc: map[string]interface{}{
+				"name":     "shoe",
+				"price":    1,
+				"location": []interface{}{95, 2},
+				"vec":      []interface{}{0.1, 0.2},
+			},
+			want: []string{"location: latitude 95 is out of range", "vec: must have 3 dimensions, not 2"},
+		},
+		{
+			name: "nested",
+			doc: map[string]interface{}{
+				"name":     "shoe",
+				"price":    1,
+				"brand":    map[string]interface{}{"country": true},
+				"variants": []interface{}{map[string]interface{}{"sku": 7}},
+			},
+			want: []string{"brand.country: must be a string, not boolean", "variants.sku[0]: must be a string, not number"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := productsSchema.ValidateDocument(tt.doc)
+			var errs ValidationErrors
+			require.True(t, errors.As(err, &errs))
+
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCollectionSchema_CoerceDocument(t *testing.T) {
+	doc := map[string]interface{}{
+		"name":    42,
+		"price":   "9.5",
+		"stock":   "12",
+		"in_sale": "oops",
+		"brand":   map[string]interface{}{"country": true},
+	}
+
+	res, err := productsSchema.CoerceDocument(doc, CoerceOrDrop)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":  "42",
+		"price": 9.5,
+		"stock": int64(12),
+		"brand": map[string]interface{}{"country": "true"},
+	}, res)
+	assert.Equal(t, 42, doc["name"], "the document passed in is not modified")
+
+	_, err = productsSchema.CoerceDocument(doc, "")
+	assert.EqualError(t, err, "in_sale: must be a boolean, not string")
+
+	// invalid optional fields are dropped, required ones still fail
+	_, err = productsSchema.CoerceDocument(doc, Drop)
+	assert.EqualError(t, err, "name: must be a string, not number; price: must be a number, not string")
+
+	doc["name"], doc["price"] = "shoe", 9.5
+	res, err = productsSchema.CoerceDocument(doc, Drop)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":  "shoe",
+		"price": 9.5,
+		"brand": map[string]interface{}{},
+	}, res)
+
+	_, err = productsSchema.CoerceDocument(doc, "ignore")
+	assert.Error(t, err)
+}
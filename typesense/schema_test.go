@@ -0,0 +1,143 @@
+package typesense
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldType(t *testing.T) {
+	assert.True(t, FieldTypeStringAuto.Valid())
+	assert.True(t, FieldType("geopoint[]").Valid())
+	assert.False(t, FieldType("int").Valid())
+	assert.False(t, FieldType("string []").Valid())
+
+	assert.True(t, FieldTypeObjectArray.IsArray())
+	assert.True(t, FieldTypeObjectArray.IsObject())
+	assert.True(t, FieldTypeInt64.IsNumeric())
+	assert.False(t, FieldTypeInt64Array.IsNumeric())
+}
+
+func TestCollectionSchema_Validate(t *testing.T) {
+	valid := &CollectionSchema{
+		Name:               "companies",
+		EnableNestedFields: Bool(true),
+		Fields: []*Field{
+			{Name: "company_name", Type: FieldTypeString, Sort: Bool(true)},
+			{Name: "num_employees", Type: FieldTypeInt32},
+			{Name: "country", Type: FieldTypeString, Facet: Bool(true)},
+			{Name: "address", Type: FieldTypeObject},
+			{Name: "location", Type: FieldTypeGeopoint},
+			{Name: ".*_facet", Type: FieldTypeAuto, Facet: Bool(true)},
+			{Name: "embedding", Type: FieldTypeFloatArray, NumDim: Int(384)},
+			{Name: "owner_id", Type: FieldTypeString, Reference: String("owners.id")},
+		},
+		DefaultSortingField: String("num_employees"),
+	}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		schema *CollectionSchema
+		want   []string
+	}{
+		{
+			name:   "name",
+			schema: &CollectionSchema{Fields: []*Field{{Name: "title", Type: FieldTypeString}}},
+			want:   []string{"name: must not be empty"},
+		},
+		{
+			name: "types",
+			schema: &CollectionSchema{Name: "c", Fields: []*Field{
+				{Name: "a", Type: "int"},
+				{Name: "b", Type: "string []"},
+				{Name: "c", Type: FieldTypeObject},
+			}},
+			want: []string{
+				`fields[0].type: unknown type "int"`,
+				`fields[1].type: unknown type "string []"`,
+				"fields[2].type: object requires enable_nested_fields",
+			},
+		},
+		{
+			name: "duplicate",
+			schema: &CollectionSchema{Name: "c", Fields: []*Field{
+				{Name: "a", Type: FieldTypeString},
+				{Name: "a", Type: FieldTypeInt32},
+			}},
+			want: []string{`fields[1].name: duplicate field "a"`},
+		},
+		{
+			name: "default sorting field",
+			schema: &CollectionSchema{Name: "c", Fields: []*Field{
+				{Name: "a", Type: FieldTypeString},
+			}, DefaultSortingField: String("a")},
+			want: []string{`default_sorting_field: field "a" must be of type int32, int64 or float, not string`},
+		},
+		{
+			name: "missing default sorting field",
+			schema: &CollectionSchema{Name: "c", Fields: []*Field{
+				{Name: "a", Type: FieldTypeInt32},
+			}, DefaultSortingField: String("b")},
+			want: []string{`default_sorting_field: unknown field "b"`},
+		},
+		{
+			name: "vectors",
+			schema: &CollectionSchema{Name: "c", Fields: []*Field{
+				{Name: "a", Type: FieldTypeFloat, NumDim: Int(3)},
+				{Name: "b", Type: FieldTypeFloatArray, NumDim: Int(0), Facet: Bool(true)},
+			}},
+			want: []string{
+				"fields[0].num_dim: requires type float[], not float",
+				"fields[1].num_dim: must be positive",
+				"fields[1].facet: is not supported for vector fields",
+			},
+		},
+		{
+			name: "facet and sort",
+			schema: &CollectionSchema{Name: "c", Fields: []*Field{
+				{Name: "a", Type: FieldTypeGeopoint, Facet: Bool(true)},
+				{Name: "b", Type: FieldTypeStringArray, Sort: Bool(true)},
+			}},
+			want: []string{
+				"fields[0].facet: is not supported for type geopoint",
+				"fields[1].sort: is not supported for type string[]",
+			},
+		},
+		{
+			name: "reference",
+			schema: &CollectionSchema{Name: "c", Fields: []*Field{
+				{Name: "a", Type: FieldTypeString, Reference: String("owners")},
+			}},
+			want: []string{`fields[0].reference: must be of the form collection.field, not "owners"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Validate()
+			var errs ValidationErrors
+			require.True(t, errors.As(err, &errs))
+
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCollectionSchema_ValidateEmbed(t *testing.T) {
+	schema := &CollectionSchema{Name: "products", Fields: []*Field{
+		{Name: "name", Type: FieldTypeString},
+		{Name: "price", Type: FieldTypeFloat},
+		{Name: "embedding", Type: FieldTypeFloatArray},
+	}}
+	schema.Fields[2].Embed = Embed(BuiltinModel(ModelAllMiniLML12V2), "name", "price", "description")
+
+	err := schema.Validate()
+	assert.EqualError(t, err, `fields[2].embed.from: field "price" must be of type string, string[] or image, not float; `+
+		`fields[2].embed.from: unknown field "description"`)
+}
@@ -0,0 +1,261 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultSnapshotInterval = 24 * time.Hour
+	defaultSnapshotKeep     = 7
+	defaultSnapshotPrefix   = "snapshot-"
+
+	snapshotTimeLayout = "20060102T150405Z"
+)
+
+// ErrNoHealthyNode is returned when none of the nodes passes its health check.
+var ErrNoHealthyNode = errors.New("typesense: no healthy node")
+
+// SnapshotStorage gives access to the directory the snapshots are written to,
+// e.g. a volume shared with the server or a bucket the snapshots are synced
+// to, so that a SnapshotManager can verify and prune them.
+type SnapshotStorage interface {
+	// List returns the names of the entries of the snapshot directory.
+	List(ctx context.Context) ([]string, error)
+
+	// Delete removes the snapshot with the name and everything in it.
+	Delete(ctx context.Context, name string) error
+}
+
+// DirStorage is a SnapshotStorage for a directory of the local file system,
+// for servers whose snapshot directory is mounted locally.
+type DirStorage string
+
+// List implements SnapshotStorage. It returns the subdirectories only.
+func (d DirStorage) List(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(string(d))
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
+
+// Delete implements SnapshotStorage.
+func (d DirStorage) Delete(ctx context.Context, name string) error {
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return fmt.Errorf("typesense: invalid snapshot name %q", name)
+	}
+	return os.RemoveAll(filepath.Join(string(d), name))
+}
+
+// Snapshot is a snapshot taken by a SnapshotManager.
+type Snapshot struct {
+	// Name is the name of the snapshot directory, Prefix followed by the
+	// UTC time the snapshot was taken at.
+	Name string
+
+	// Path is the directory the snapshot was written to on the server.
+	Path string
+
+	// Node is the URL of the node the snapshot was taken on.
+	Node      string
+	CreatedAt time.Time
+}
+
+// SnapshotManager takes snapshots of a cluster on a schedule. Each snapshot is
+// taken on a healthy follower if there is one, so the leader keeps serving
+// writes undisturbed, and written to a directory named by its timestamp
+// under Dir. If Storage is set, the snapshot is verified to exist afterwards
+// and old snapshots are pruned according to Keep and MaxAge.
+type SnapshotManager struct {
+	nodes []*Node
+
+	// Dir is the directory on the servers the snapshots are written to.
+	Dir string
+
+	// Prefix is prepended to the timestamp to name the snapshots. Only
+	// directories with the prefix and a timestamp are pruned.
+	// Default: "snapshot-"
+	Prefix string
+
+	// Interval is the time between two snapshots.
+	// Default: 24h
+	Interval time.Duration
+
+	// Keep is the number of most recent snapshots kept when pruning; 0 keeps
+	// all. Default: 7
+	Keep int
+
+	// MaxAge, if positive, prunes snapshots older than it. The most recent
+	// snapshot is never pruned.
+	MaxAge time.Duration
+
+	// Storage, if set, is used to verify and prune the snapshots.
+	Storage SnapshotStorage
+
+	// OnError, if set, is called with the errors of the scheduled snapshots.
+	OnError func(error)
+
+	now func() time.Time
+}
+
+// NewSnapshotManager returns a SnapshotManager writing snapshots to dir on the
+// nodes, which need both Meta and Operations set.
+func NewSnapshotManager(dir string, nodes ...*Node) *SnapshotManager {
+	return &SnapshotManager{
+		nodes:    nodes,
+		Dir:      dir,
+		Prefix:   defaultSnapshotPrefix,
+		Interval: defaultSnapshotInterval,
+		Keep:     defaultSnapshotKeep,
+		now:      time.Now,
+	}
+}
+
+// Run takes a snapshot every Interval until ctx is done, the first one after
+// one Interval.
+func (m *SnapshotManager) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if _, err := m.Snapshot(ctx); err != nil && m.OnError != nil {
+			m.OnError(err)
+		}
+	}
+}
+
+// Snapshot takes a snapshot now and prunes old snapshots. A snapshot that
+// succeeded is returned even if pruning fails.
+func (m *SnapshotManager) Snapshot(ctx context.Context) (*Snapshot, error) {
+	node, err := m.choose(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := m.now().UTC()
+	name := m.Prefix + createdAt.Format(snapshotTimeLayout)
+	snap := &Snapshot{
+		Name:      name,
+		Path:      path.Join(m.Dir, name),
+		Node:      node.URL,
+		CreatedAt: createdAt,
+	}
+
+	res, err := node.Operations.Snapshot(ctx, &TakeSnapshotParams{SnapshotPath: snap.Path})
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %s on %s: %w", snap.Path, node.URL, err)
+	}
+	if !res.Success {
+		return nil, fmt.Errorf("snapshot %s on %s: not successful", snap.Path, node.URL)
+	}
+	if m.Storage == nil {
+		return snap, nil
+	}
+
+	names, err := m.Storage.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("verify snapshot %s: %w", name, err)
+	}
+	if !slices.Contains(names, name) {
+		return nil, fmt.Errorf("verify snapshot %s: not found in storage", name)
+	}
+
+	if _, err := m.Prune(ctx); err != nil {
+		return snap, err
+	}
+	return snap, nil
+}
+
+// choose returns the first healthy follower, or the healthy leader if there
+// is no follower.
+func (m *SnapshotManager) choose(ctx context.Context) (*Node, error) {
+	var leader *Node
+	for _, n := range m.nodes {
+		health, err := n.Meta.Health(ctx)
+		if err != nil || !health.Ok {
+			continue
+		}
+		status, err := n.Meta.Status(ctx)
+		if err != nil {
+			continue
+		}
+		switch status.State {
+		case RaftFollower:
+			return n, nil
+		case RaftLeader:
+			leader = n
+		}
+	}
+	if leader == nil {
+		return nil, ErrNoHealthyNode
+	}
+	return leader, nil
+}
+
+// Prune deletes the snapshots in Storage beyond the Keep most recent ones and
+// those older than MaxAge, and returns the names of the deleted snapshots.
+func (m *SnapshotManager) Prune(ctx context.Context) ([]string, error) {
+	if m.Storage == nil {
+		return nil, errors.New("typesense: no snapshot storage")
+	}
+	names, err := m.Storage.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		name      string
+		createdAt time.Time
+	}
+	var snapshots []entry
+	for _, name := range names {
+		ts, ok := strings.CutPrefix(name, m.Prefix)
+		if !ok {
+			continue
+		}
+		createdAt, err := time.Parse(snapshotTimeLayout, ts)
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, entry{name, createdAt})
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].createdAt.After(snapshots[j].createdAt)
+	})
+
+	now := m.now()
+	var deleted []string
+	for i, s := range snapshots {
+		if i == 0 {
+			continue
+		}
+		expired := m.Keep > 0 && i >= m.Keep || m.MaxAge > 0 && now.Sub(s.createdAt) > m.MaxAge
+		if !expired {
+			continue
+		}
+		if err := m.Storage.Delete(ctx, s.name); err != nil {
+			return deleted, fmt.Errorf("delete snapshot %s: %w", s.name, err)
+		}
+		deleted = append(deleted, s.name)
+	}
+	return deleted, nil
+}
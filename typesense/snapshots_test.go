@@ -0,0 +1,145 @@
+package typesense
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOperations is an OperationsAPI creating the snapshot directories in a
+// local directory standing in for the server's file system.
+type fakeOperations struct {
+	OperationsAPI
+
+	dir     string
+	success bool
+	paths   []string
+}
+
+func (o *fakeOperations) Snapshot(ctx context.Context, opts *TakeSnapshotParams) (*SuccessStatus, error) {
+	o.paths = append(o.paths, opts.SnapshotPath)
+	if o.success {
+		if err := os.Mkdir(filepath.Join(o.dir, path.Base(opts.SnapshotPath)), 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return &SuccessStatus{Success: o.success}, nil
+}
+
+func snapshotNode(url, dir string, state RaftState) (*Node, *fakeOperations) {
+	meta := &fakeMeta{}
+	meta.set(true, nil, state, 0)
+	ops := &fakeOperations{dir: dir, success: true}
+	return &Node{URL: url, Meta: meta, Operations: ops}, ops
+}
+
+func listDir(t *testing.T, dir string) []string {
+	names, err := DirStorage(dir).List(context.Background())
+	require.NoError(t, err)
+	sort.Strings(names)
+	return names
+}
+
+func TestSnapshotManager_Snapshot(t *testing.T) {
+	dir := t.TempDir()
+	leader, leaderOps := snapshotNode("http://node1:8108", dir, RaftLeader)
+	follower, followerOps := snapshotNode("http://node2:8108", dir, RaftFollower)
+
+	m := NewSnapshotManager("/var/lib/typesense/snapshots", leader, follower)
+	m.Storage = DirStorage(dir)
+	m.now = func() time.Time { return time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	snap, err := m.Snapshot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Snapshot{
+		Name:      "snapshot-20240301T120000Z",
+		Path:      "/var/lib/typesense/snapshots/snapshot-20240301T120000Z",
+		Node:      "http://node2:8108",
+		CreatedAt: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+	}, snap)
+	assert.Empty(t, leaderOps.paths)
+	assert.Equal(t, []string{snap.Path}, followerOps.paths)
+
+	// the leader is used if no follower is healthy
+	follower.Meta.(*fakeMeta).set(false, nil, RaftFollower, 0)
+	m.now = func() time.Time { return time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC) }
+	snap, err = m.Snapshot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://node1:8108", snap.Node)
+
+	leader.Meta.(*fakeMeta).set(false, nil, RaftLeader, 0)
+	_, err = m.Snapshot(context.Background())
+	assert.ErrorIs(t, err, ErrNoHealthyNode)
+}
+
+func TestSnapshotManager_SnapshotVerify(t *testing.T) {
+	dir := t.TempDir()
+	node, ops := snapshotNode("http://node1:8108", dir, RaftLeader)
+
+	m := NewSnapshotManager("/snapshots", node)
+	ops.success = false
+	_, err := m.Snapshot(context.Background())
+	assert.ErrorContains(t, err, "not successful")
+
+	// the server reports success, but the snapshot never shows up
+	ops.success = true
+	ops.dir = t.TempDir()
+	m.Storage = DirStorage(dir)
+	_, err = m.Snapshot(context.Background())
+	assert.ErrorContains(t, err, "not found in storage")
+}
+
+func TestSnapshotManager_Prune(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"snapshot-20240301T120000Z",
+		"snapshot-20240302T120000Z",
+		"snapshot-20240303T120000Z",
+		"snapshot-20240304T120000Z",
+		"snapshot-latest",
+		"other",
+	} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0o755))
+	}
+	node, _ := snapshotNode("http://node1:8108", dir, RaftLeader)
+
+	m := NewSnapshotManager("/snapshots", node)
+	m.Storage = DirStorage(dir)
+	m.Keep = 3
+	m.now = func() time.Time { return time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC) }
+
+	deleted, err := m.Prune(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snapshot-20240301T120000Z"}, deleted)
+
+	m.MaxAge = 36 * time.Hour
+	snap, err := m.Snapshot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"other",
+		"snapshot-20240304T120000Z",
+		snap.Name,
+		"snapshot-latest",
+	}, listDir(t, dir))
+
+	// the most recent snapshot is kept regardless of its age
+	m.now = func() time.Time { return time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC) }
+	deleted, err = m.Prune(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snapshot-20240304T120000Z"}, deleted)
+	assert.Equal(t, []string{"other", snap.Name, "snapshot-latest"}, listDir(t, dir))
+}
+
+func TestDirStorage_Delete(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"", ".", "..", "../x", "a/b"} {
+		assert.Error(t, DirStorage(dir).Delete(context.Background(), name), name)
+	}
+}
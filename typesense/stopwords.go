@@ -0,0 +1,90 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type StopwordsService service
+
+func (s *StopwordsService) List(ctx context.Context) (*StopwordsSetsResponse, error) {
+	u := "/stopwords"
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &StopwordsSetsResponse{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *StopwordsService) Get(ctx context.Context, stopwordsSetId string) (*StopwordsSet, error) {
+	u := fmt.Sprintf("/stopwords/%s", stopwordsSetId)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &stopwordsSetRetrieve{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return (*StopwordsSet)(res), nil
+}
+
+// stopwordsSetRetrieve decodes the `{"stopwords": {...}}` object returned by
+// the server when retrieving a single set.
+type stopwordsSetRetrieve StopwordsSet
+
+func (r *stopwordsSetRetrieve) UnmarshalJSON(data []byte) error {
+	var res struct {
+		Stopwords json.RawMessage `json:"stopwords"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	if len(res.Stopwords) > 0 && res.Stopwords[0] == '{' {
+		data = res.Stopwords
+	}
+	return json.Unmarshal(data, (*StopwordsSet)(r))
+}
+
+func (s *StopwordsService) Upsert(ctx context.Context, stopwordsSetId string, body *StopwordsSetSchema) (*StopwordsSet, error) {
+	u := fmt.Sprintf("/stopwords/%s", stopwordsSetId)
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &StopwordsSet{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type DeleteStopwordsSetResponse struct {
+	ID string `json:"id"`
+}
+
+func (s *StopwordsService) Delete(ctx context.Context, stopwordsSetId string) (*DeleteStopwordsSetResponse, error) {
+	u := fmt.Sprintf("/stopwords/%s", stopwordsSetId)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &DeleteStopwordsSetResponse{}
+	err = s.client.Do(ctx, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
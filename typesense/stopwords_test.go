@@ -0,0 +1,134 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStopwordsService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stopwords", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{
+			"stopwords": [
+				{
+					"id": "stopword_set1",
+					"locale": "en",
+					"stopwords": ["the", "a"]
+				}
+			]
+		}`)
+	})
+
+	want := &StopwordsSetsResponse{
+		Stopwords: []*StopwordsSet{{
+			Id:        "stopword_set1",
+			Locale:    String("en"),
+			Stopwords: []string{"the", "a"},
+		}},
+	}
+
+	got, err := client.Stopwords.List(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestStopwordsService_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stopwords/stopword_set1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{
+			"stopwords": {
+				"id": "stopword_set1",
+				"locale": "en",
+				"stopwords": ["the", "a"]
+			}
+		}`)
+	})
+
+	want := &StopwordsSet{
+		Id:        "stopword_set1",
+		Locale:    String("en"),
+		Stopwords: []string{"the", "a"},
+	}
+
+	got, err := client.Stopwords.Get(context.Background(), "stopword_set1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestStopwordsService_Upsert(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stopwords/stopword_set1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body := &StopwordsSetSchema{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		assert.Equal(t, []string{"the", "a"}, body.Stopwords)
+		fmt.Fprint(w, `{
+			"id": "stopword_set1",
+			"locale": "en",
+			"stopwords": ["the", "a"]
+		}`)
+	})
+
+	want := &StopwordsSet{
+		Id:        "stopword_set1",
+		Locale:    String("en"),
+		Stopwords: []string{"the", "a"},
+	}
+
+	got, err := client.Stopwords.Upsert(context.Background(), "stopword_set1", &StopwordsSetSchema{
+		Locale:    String("en"),
+		Stopwords: []string{"the", "a"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestStopwordsService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stopwords/stopword_set1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		fmt.Fprint(w, `{"id": "stopword_set1"}`)
+	})
+
+	got, err := client.Stopwords.Delete(context.Background(), "stopword_set1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &DeleteStopwordsSetResponse{ID: "stopword_set1"}, got)
+}
+
+func TestStopwords_SearchParameter(t *testing.T) {
+	u, err := addOptions("/collections/products/documents/search", &SearchParameters{
+		Q:         "the shoe",
+		QueryBy:   "name",
+		Stopwords: String("stopword_set1"),
+	})
+	assert.NoError(t, err)
+	parsed, err := url.Parse(u)
+	assert.NoError(t, err)
+	assert.Equal(t, "stopword_set1", parsed.Query().Get("stopwords"))
+
+	u, err = addOptions("/multi_search", &MultiSearchParameters{Stopwords: String("stopword_set1")})
+	assert.NoError(t, err)
+	parsed, err = url.Parse(u)
+	assert.NoError(t, err)
+	assert.Equal(t, "stopword_set1", parsed.Query().Get("stopwords"))
+}
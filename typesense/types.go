@@ -33,22 +33,22 @@ const (
 
 // ApiKey defines model for ApiKey.
 type ApiKey struct {
-	Actions     []string `json:"actions"`
-	Collections []string `json:"collections"`
-	Description string   `json:"description"`
-	ExpiresAt   *int64   `json:"expires_at,omitempty"`
-	Id          *int64   `json:"id,omitempty"`
-	Value       *string  `json:"value,omitempty"`
-	ValuePrefix *string  `json:"value_prefix,omitempty"`
+	Actions     []KeyAction `json:"actions"`
+	Collections []string    `json:"collections"`
+	Description string      `json:"description"`
+	ExpiresAt   *int64      `json:"expires_at,omitempty"`
+	Id          *int64      `json:"id,omitempty"`
+	Value       *string     `json:"value,omitempty"`
+	ValuePrefix *string     `json:"value_prefix,omitempty"`
 }
 
 // ApiKeySchema defines model for ApiKeySchema.
 type ApiKeySchema struct {
-	Actions     []string `json:"actions"`
-	Collections []string `json:"collections"`
-	Description *string  `json:"description"`
-	ExpiresAt   *int64   `json:"expires_at,omitempty"`
-	Value       *string  `json:"value,omitempty"`
+	Actions     []KeyAction `json:"actions"`
+	Collections []string    `json:"collections"`
+	Description *string     `json:"description"`
+	ExpiresAt   *int64      `json:"expires_at,omitempty"`
+	Value       *string     `json:"value,omitempty"`
 }
 
 // ApiKeysResponse defines model for ApiKeysResponse.
@@ -126,27 +126,55 @@ type CollectionSchema struct {
 
 // Field defines model for Field.
 type Field struct {
-	Drop  *bool `json:"drop,omitempty"`
-	Embed *struct {
-		From        []string `json:"from"`
-		ModelConfig *struct {
-			AccessToken  *string `json:"access_token,omitempty"`
-			ApiKey       *string `json:"api_key,omitempty"`
-			ClientId     *string `json:"client_id,omitempty"`
-			ClientSecret *string `json:"client_secret,omitempty"`
-			ModelName    *string `json:"model_name"`
-			ProjectId    *string `json:"project_id,omitempty"`
-		} `json:"model_config"`
-	} `json:"embed,omitempty"`
-	Facet    *bool   `json:"facet,omitempty"`
-	Index    *bool   `json:"index,omitempty"`
-	Infix    *bool   `json:"infix,omitempty"`
-	Locale   *string `json:"locale,omitempty"`
-	Name     string  `json:"name"`
-	NumDim   *int    `json:"num_dim,omitempty"`
-	Optional *bool   `json:"optional,omitempty"`
-	Sort     *bool   `json:"sort,omitempty"`
-	Type     string  `json:"type"`
+	Drop     *bool        `json:"drop,omitempty"`
+	Embed    *EmbedConfig `json:"embed,omitempty"`
+	Facet    *bool        `json:"facet,omitempty"`
+	Index    *bool        `json:"index,omitempty"`
+	Infix    *bool        `json:"infix,omitempty"`
+	Locale   *string      `json:"locale,omitempty"`
+	Name     string       `json:"name"`
+	NumDim   *int         `json:"num_dim,omitempty"`
+	Optional *bool        `json:"optional,omitempty"`
+
+	// Reference Name of a field in another collection, e.g. `authors.id`,
+	// that this field refers to. Referenced collections can be joined with
+	// `$collection(...)` in filter_by and include_fields.
+	Reference *string   `json:"reference,omitempty"`
+	Sort      *bool     `json:"sort,omitempty"`
+	Type      FieldType `json:"type"`
+}
+
+// EmbedConfig defines model for EmbedConfig.
+type EmbedConfig struct {
+	// From Names of the string, string[] or image fields the embedding is
+	// generated from
+	From        []string     `json:"from"`
+	ModelConfig *ModelConfig `json:"model_config"`
+}
+
+// ModelConfig defines model for ModelConfig.
+type ModelConfig struct {
+	AccessToken  *string `json:"access_token,omitempty"`
+	ApiKey       *string `json:"api_key,omitempty"`
+	ClientId     *string `json:"client_id,omitempty"`
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// IndexingPrefix Prefix added to the text of a document before it is
+	// embedded, e.g. `passage:` for the e5 models
+	IndexingPrefix *string `json:"indexing_prefix,omitempty"`
+
+	// ModelName Name of the model, e.g. `ts/e5-small` or
+	// `openai/text-embedding-ada-002`
+	ModelName *string `json:"model_name"`
+	ProjectId *string `json:"project_id,omitempty"`
+
+	// QueryPrefix Prefix added to the search query before it is embedded,
+	// e.g. `query:` for the e5 models
+	QueryPrefix  *string `json:"query_prefix,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+
+	// Url Base URL of an OpenAI-compatible API to use instead of OpenAI's
+	Url *string `json:"url,omitempty"`
 }
 
 // CollectionAlias defines model for CollectionAlias.
@@ -393,6 +421,10 @@ type MultiSearchCollectionParameters struct {
 	// `_text_match:desc,default_sorting_field:desc`
 	SortBy *string `json:"sort_by,omitempty"`
 
+	// Stopwords Name of the stopwords set to apply for this search. The
+	// keywords present in the set will be removed from the search query.
+	Stopwords *string `json:"stopwords,omitempty"`
+
 	// TypoTokensThreshold If the number of results found for a specific query
 	// is less than this number, Typesense will attempt to look for tokens with
 	// more typos until enough results are found. Default: 100
@@ -412,83 +444,94 @@ type MultiSearchParameters struct {
 	// CacheTtl The duration (in seconds) that determines how long the search
 	// query is cached.  This value can be set on a per-query basis. Default:
 	// 60.
-	CacheTtl *int `json:"cache_ttl,omitempty"`
+	CacheTtl *int `json:"cache_ttl,omitempty" url:"cache_ttl,omitempty"`
+
+	// Conversation Enable conversational search. The answer generated by the
+	// conversation model is returned in MultiSearchResult.Conversation.
+	Conversation *bool `json:"conversation,omitempty" url:"conversation,omitempty"`
+
+	// ConversationId The id of a previous conversation to continue.
+	ConversationId *string `json:"conversation_id,omitempty" url:"conversation_id,omitempty"`
+
+	// ConversationModelId The id of the conversation model used to answer
+	// the query.
+	ConversationModelId *string `json:"conversation_model_id,omitempty" url:"conversation_model_id,omitempty"`
 
 	// DropTokensThreshold If the number of results found for a specific query
 	// is less than this number, Typesense will attempt to drop the tokens in
 	// the query until enough results are found. Tokens that have the least
 	// individual hits are dropped first. Set to 0 to disable. Default: 10
-	DropTokensThreshold *int `json:"drop_tokens_threshold,omitempty"`
+	DropTokensThreshold *int `json:"drop_tokens_threshold,omitempty" url:"drop_tokens_threshold,omitempty"`
 
 	// EnableOverrides If you have some overrides defined but want to disable
 	// all of them during query time, you can do that by setting this parameter
 	// to false
-	EnableOverrides *bool `json:"enable_overrides,omitempty"`
+	EnableOverrides *bool `json:"enable_overrides,omitempty" url:"enable_overrides,omitempty"`
 
 	// ExcludeFields List of fields from the document to exclude in the search
 	// result
-	ExcludeFields *string `json:"exclude_fields,omitempty"`
+	ExcludeFields *string `json:"exclude_fields,omitempty" url:"exclude_fields,omitempty"`
 
 	// ExhaustiveSearch Setting this to true will make Typesense consider all
 	// prefixes and typo  corrections of the words in the query without stopping
 	// early when enough results are found  (drop_tokens_threshold and
 	// typo_tokens_threshold configurations are ignored).
-	ExhaustiveSearch *bool `json:"exhaustive_search,omitempty"`
+	ExhaustiveSearch *bool `json:"exhaustive_search,omitempty" url:"exhaustive_search,omitempty"`
 
 	// FacetBy A list of fields that will be used for faceting your results on.
 	// Separate multiple fields with a comma.
-	FacetBy *string `json:"facet_by,omitempty"`
+	FacetBy *string `json:"facet_by,omitempty" url:"facet_by,omitempty"`
 
 	// FacetQuery Facet values that are returned can now be filtered via this
 	// parameter. The matching facet text is also highlighted. For example, when
 	// faceting by `category`, you can set `facet_query=category:shoe` to return
 	// only facet values that contain the prefix "shoe".
-	FacetQuery *string `json:"facet_query,omitempty"`
+	FacetQuery *string `json:"facet_query,omitempty" url:"facet_query,omitempty"`
 
 	// FilterBy Filter conditions for refining youropen api validator search
 	// results. Separate multiple conditions with &&.
-	FilterBy *string `json:"filter_by,omitempty"`
+	FilterBy *string `json:"filter_by,omitempty" url:"filter_by,omitempty"`
 
 	// GroupBy You can aggregate search results into groups or buckets by
 	// specify one or more `group_by` fields. Separate multiple fields with a
 	// comma. To group on a particular field, it must be a faceted field.
-	GroupBy *string `json:"group_by,omitempty"`
+	GroupBy *string `json:"group_by,omitempty" url:"group_by,omitempty"`
 
 	// GroupLimit Maximum number of hits to be returned for every group. If the
 	// `group_limit` is set as `K` then only the top K hits in each group are
 	// returned in the response. Default: 3
-	GroupLimit *int `json:"group_limit,omitempty"`
+	GroupLimit *int `json:"group_limit,omitempty" url:"group_limit,omitempty"`
 
 	// HiddenHits A list of records to unconditionally hide from search results.
 	// A list of `record_id`s to hide. Eg: to hide records with IDs 123 and 456,
 	// you'd specify `123,456`. You could also use the Overrides feature to
 	// override search results based on rules. Overrides are applied first,
 	// followed by `pinned_hits` and finally `hidden_hits`.
-	HiddenHits *string `json:"hidden_hits,omitempty"`
+	HiddenHits *string `json:"hidden_hits,omitempty" url:"hidden_hits,omitempty"`
 
 	// HighlightAffixNumTokens The number of tokens that should surround the
 	// highlighted text on each side. Default: 4
-	HighlightAffixNumTokens *int `json:"highlight_affix_num_tokens,omitempty"`
+	HighlightAffixNumTokens *int `json:"highlight_affix_num_tokens,omitempty" url:"highlight_affix_num_tokens,omitempty"`
 
 	// HighlightEndTag The end tag used for the highlighted snippets. Default:
 	// `</mark>`
-	HighlightEndTag *string `json:"highlight_end_tag,omitempty"`
+	HighlightEndTag *string `json:"highlight_end_tag,omitempty" url:"highlight_end_tag,omitempty"`
 
 	// HighlightFields A list of custom fields that must be highlighted even if
 	// you don't query  for them
-	HighlightFields *string `json:"highlight_fields,omitempty"`
+	HighlightFields *string `json:"highlight_fields,omitempty" url:"highlight_fields,omitempty"`
 
 	// HighlightFullFields List of fields which should be highlighted fully
 	// without snippeting
-	HighlightFullFields *string `json:"highlight_full_fields,omitempty"`
+	HighlightFullFields *string `json:"highlight_full_fields,omitempty" url:"highlight_full_fields,omitempty"`
 
 	// HighlightStartTag The start tag used for the highlighted snippets.
 	// Default: `<mark>`
-	HighlightStartTag *string `json:"highlight_start_tag,omitempty"`
+	HighlightStartTag *string `json:"highlight_start_tag,omitempty" url:"highlight_start_tag,omitempty"`
 
 	// IncludeFields List of fields from the document to include in the search
 	// result
-	IncludeFields *string `json:"include_fields,omitempty"`
+	IncludeFields *string `json:"include_fields,omitempty" url:"include_fields,omitempty"`
 
 	// Infix If infix index is enabled for this field, infix searching can be
 	// done on a per-field basis by sending a comma separated string parameter
@@ -496,7 +539,7 @@ type MultiSearchParameters struct {
 	// infix search is disabled, which is default `always` infix search is
 	// performed along with regular search `fallback` infix search is performed
 	// if regular search does not produce results
-	Infix *string `json:"infix,omitempty"`
+	Infix *string `json:"infix,omitempty" url:"infix,omitempty"`
 
 	// MaxExtraPrefix There are also 2 parameters that allow you to control the
 	// extent of infix searching max_extra_prefix and max_extra_suffix which
@@ -504,7 +547,7 @@ type MultiSearchParameters struct {
 	// be present in the token. For example query "K2100" has 2 extra symbols in
 	// "6PK2100". By default, any number of prefixes/suffixes can be present for
 	// a match.
-	MaxExtraPrefix *int `json:"max_extra_prefix,omitempty"`
+	MaxExtraPrefix *int `json:"max_extra_prefix,omitempty" url:"max_extra_prefix,omitempty"`
 
 	// MaxExtraSuffix There are also 2 parameters that allow you to control the
 	// extent of infix searching max_extra_prefix and max_extra_suffix which
@@ -512,28 +555,28 @@ type MultiSearchParameters struct {
 	// be present in the token. For example query "K2100" has 2 extra symbols in
 	// "6PK2100". By default, any number of prefixes/suffixes can be present for
 	// a match.
-	MaxExtraSuffix *int `json:"max_extra_suffix,omitempty"`
+	MaxExtraSuffix *int `json:"max_extra_suffix,omitempty" url:"max_extra_suffix,omitempty"`
 
 	// MaxFacetValues Maximum number of facet values to be returned.
-	MaxFacetValues *int `json:"max_facet_values,omitempty"`
+	MaxFacetValues *int `json:"max_facet_values,omitempty" url:"max_facet_values,omitempty"`
 
 	// MinLen1typo Minimum word length for 1-typo correction to be applied.  The
 	// value of num_typos is still treated as the maximum allowed typos.
-	MinLen1typo *int `json:"min_len_1typo,omitempty"`
+	MinLen1typo *int `json:"min_len_1typo,omitempty" url:"min_len_1typo,omitempty"`
 
 	// MinLen2typo Minimum word length for 2-typo correction to be applied.  The
 	// value of num_typos is still treated as the maximum allowed typos.
-	MinLen2typo *int `json:"min_len_2typo,omitempty"`
+	MinLen2typo *int `json:"min_len_2typo,omitempty" url:"min_len_2typo,omitempty"`
 
 	// NumTypos The number of typographical errors (1 or 2) that would be
 	// tolerated. Default: 2
-	NumTypos *string `json:"num_typos,omitempty"`
+	NumTypos *string `json:"num_typos,omitempty" url:"num_typos,omitempty"`
 
 	// Page Results from this specific page number would be fetched.
-	Page *int `json:"page,omitempty"`
+	Page *int `json:"page,omitempty" url:"page,omitempty"`
 
 	// PerPage Number of results to fetch per page. Default: 10
-	PerPage *int `json:"per_page,omitempty"`
+	PerPage *int `json:"per_page,omitempty" url:"per_page,omitempty"`
 
 	// PinnedHits A list of records to unconditionally include in the search
 	// results at specific positions. An example use case would be to feature or
@@ -543,58 +586,58 @@ type MultiSearchParameters struct {
 	// `123:1,456:5`. You could also use the Overrides feature to override
 	// search results based on rules. Overrides are applied first, followed by
 	// `pinned_hits` and  finally `hidden_hits`.
-	PinnedHits *string `json:"pinned_hits,omitempty"`
+	PinnedHits *string `json:"pinned_hits,omitempty" url:"pinned_hits,omitempty"`
 
 	// PreSegmentedQuery You can index content from any logographic language
 	// into Typesense if you are able to segment / split the text into
 	// space-separated words yourself  before indexing and querying.
 	// Set this parameter to true to do the same
-	PreSegmentedQuery *bool `json:"pre_segmented_query,omitempty"`
+	PreSegmentedQuery *bool `json:"pre_segmented_query,omitempty" url:"pre_segmented_query,omitempty"`
 
 	// Prefix Boolean field to indicate that the last word in the query should
 	// be treated as a prefix, and not as a whole word. This is used for
 	// building autocomplete and instant search interfaces. Defaults to true.
-	Prefix *string `json:"prefix,omitempty"`
+	Prefix *string `json:"prefix,omitempty" url:"prefix,omitempty"`
 
 	// Preset Search using a bunch of search parameters by setting this
 	// parameter to the name of the existing Preset.
-	Preset *string `json:"preset,omitempty"`
+	Preset *string `json:"preset,omitempty" url:"preset,omitempty"`
 
 	// PrioritizeExactMatch Set this parameter to true to ensure that an exact
 	// match is ranked above the others
-	PrioritizeExactMatch *bool `json:"prioritize_exact_match,omitempty"`
+	PrioritizeExactMatch *bool `json:"prioritize_exact_match,omitempty" url:"prioritize_exact_match,omitempty"`
 
 	// Q The query text to search for in the collection. Use * as the search
 	// string to return all documents. This is typically useful when used in
 	// conjunction with filter_by.
-	Q *string `json:"q,omitempty"`
+	Q *string `json:"q,omitempty" url:"q,omitempty"`
 
 	// QueryBy A list of `string` fields that should be queried against.
 	// Multiple fields are separated with a comma.
-	QueryBy *string `json:"query_by,omitempty"`
+	QueryBy *string `json:"query_by,omitempty" url:"query_by,omitempty"`
 
 	// QueryByWeights The relative weight to give each `query_by` field when
 	// ranking results. This can be used to boost fields in priority, when
 	// looking for matches. Multiple fields are separated with a comma.
-	QueryByWeights *string `json:"query_by_weights,omitempty"`
+	QueryByWeights *string `json:"query_by_weights,omitempty" url:"query_by_weights,omitempty"`
 
 	// RemoteEmbeddingNumTries Number of times to retry fetching remote
 	// embeddings.
-	RemoteEmbeddingNumTries *int `json:"remote_embedding_num_tries,omitempty"`
+	RemoteEmbeddingNumTries *int `json:"remote_embedding_num_tries,omitempty" url:"remote_embedding_num_tries,omitempty"`
 
 	// RemoteEmbeddingTimeoutMs Timeout (in milliseconds) for fetching remote
 	// embeddings.
-	RemoteEmbeddingTimeoutMs *int `json:"remote_embedding_timeout_ms,omitempty"`
+	RemoteEmbeddingTimeoutMs *int `json:"remote_embedding_timeout_ms,omitempty" url:"remote_embedding_timeout_ms,omitempty"`
 
 	// SearchCutoffMs Typesense will attempt to return results early if the
 	// cutoff time has elapsed.  This is not a strict guarantee and facet
 	// computation is not bound by this parameter.
-	SearchCutoffMs *int `json:"search_cutoff_ms,omitempty"`
+	SearchCutoffMs *int `json:"search_cutoff_ms,omitempty" url:"search_cutoff_ms,omitempty"`
 
 	// SnippetThreshold Field values under this length will be fully
 	// highlighted, instead of showing a snippet of relevant portion. Default:
 	// 30
-	SnippetThreshold *int `json:"snippet_threshold,omitempty"`
+	SnippetThreshold *int `json:"snippet_threshold,omitempty" url:"snippet_threshold,omitempty"`
 
 	// SortBy A list of numerical fields and their corresponding sort orders
 	// that will be used for ordering your results. Up to 3 sort fields can be
@@ -602,20 +645,24 @@ type MultiSearchParameters struct {
 	// `_text_match` field that you can use in the list of sorting fields. If no
 	// `sort_by` parameter is specified, results are sorted by
 	// `_text_match:desc,default_sorting_field:desc`
-	SortBy *string `json:"sort_by,omitempty"`
+	SortBy *string `json:"sort_by,omitempty" url:"sort_by,omitempty"`
+
+	// Stopwords Name of the stopwords set to apply for this search. The
+	// keywords present in the set will be removed from the search query.
+	Stopwords *string `json:"stopwords,omitempty" url:"stopwords,omitempty"`
 
 	// TypoTokensThreshold If the number of results found for a specific query
 	// is less than this number, Typesense will attempt to look for tokens with
 	// more typos until enough results are found. Default: 100
-	TypoTokensThreshold *int `json:"typo_tokens_threshold,omitempty"`
+	TypoTokensThreshold *int `json:"typo_tokens_threshold,omitempty" url:"typo_tokens_threshold,omitempty"`
 
 	// UseCache Enable server side caching of search query results. By default,
 	// caching is disabled.
-	UseCache *bool `json:"use_cache,omitempty"`
+	UseCache *bool `json:"use_cache,omitempty" url:"use_cache,omitempty"`
 
 	// VectorQuery Vector query expression for fetching documents "closest" to a
 	// given query/document vector.
-	VectorQuery *string `json:"vector_query,omitempty"`
+	VectorQuery *string `json:"vector_query,omitempty" url:"vector_query,omitempty"`
 }
 
 // MultiSearchResult defines model for MultiSearchResult.
@@ -624,6 +671,9 @@ type MultiSearchParameters struct {
 // }
 
 type MultiSearchResult struct {
+	// Conversation The answer generated for a conversational search
+	Conversation *SearchResultConversation `json:"conversation,omitempty"`
+
 	Results []ResultOrError `json:"results"`
 }
 
@@ -779,16 +829,16 @@ type SearchParameters struct {
 	// Q The query text to search for in the collection. Use * as the search
 	// string to return all documents. This is typically useful when used in
 	// conjunction with filter_by.
-	Q string `url:"q"`
+	Q string `json:"q,omitempty" url:"q"`
 
 	// QueryBy A list of `string` fields that should be queried against.
 	// Multiple fields are separated with a comma.
-	QueryBy string `url:"query_by"`
+	QueryBy string `json:"query_by,omitempty" url:"query_by"`
 
 	// Prefix Boolean field to indicate that the last word in the query should
 	// be treated as a prefix, and not as a whole word. This is used for
 	// building autocomplete and instant search interfaces. Defaults to true.
-	Prefix *string `url:"prefix,omitempty"`
+	Prefix *string `json:"prefix,omitempty" url:"prefix,omitempty"`
 
 	// Infix If infix index is enabled for this field, infix searching can be
 	// done on a per-field basis by sending a comma separated string parameter
@@ -796,7 +846,7 @@ type SearchParameters struct {
 	// infix search is disabled, which is default `always` infix search is
 	// performed along with regular search `fallback` infix search is performed
 	// if regular search does not produce results
-	Infix *string `url:"infix,omitempty"`
+	Infix *string `json:"infix,omitempty" url:"infix,omitempty"`
 
 	// MaxExtraPrefix There are also 2 parameters that allow you to control the
 	// extent of infix searching max_extra_prefix and max_extra_suffix which
@@ -804,7 +854,7 @@ type SearchParameters struct {
 	// be present in the token. For example query "K2100" has 2 extra symbols in
 	// "6PK2100". By default, any number of prefixes/suffixes can be present for
 	// a match.
-	MaxExtraPrefix *int `url:"max_extra_prefix,omitempty"`
+	MaxExtraPrefix *int `json:"max_extra_prefix,omitempty" url:"max_extra_prefix,omitempty"`
 
 	// MaxExtraSuffix There are also 2 parameters that allow you to control the
 	// extent of infix searching max_extra_prefix and max_extra_suffix which
@@ -812,35 +862,39 @@ type SearchParameters struct {
 	// be present in the token. For example query "K2100" has 2 extra symbols in
 	// "6PK2100". By default, any number of prefixes/suffixes can be present for
 	// a match.
-	MaxExtraSuffix *int `url:"max_extra_suffix,omitempty"`
+	MaxExtraSuffix *int `json:"max_extra_suffix,omitempty" url:"max_extra_suffix,omitempty"`
 
 	// PreSegmentedQuery You can index content from any logographic language
 	// into Typesense if you are able to segment / split the text into
 	// space-separated words yourself  before indexing and querying.
 	// Set this parameter to true to do the same
-	PreSegmentedQuery *bool `url:"pre_segmented_query,omitempty"`
+	PreSegmentedQuery *bool `json:"pre_segmented_query,omitempty" url:"pre_segmented_query,omitempty"`
 
 	// Preset Search using a bunch of search parameters by setting this
 	// parameter to the name of the existing Preset.
-	Preset *string `url:"preset,omitempty"`
+	Preset *string `json:"preset,omitempty" url:"preset,omitempty"`
+
+	// Stopwords Name of the stopwords set to apply for this search. The
+	// keywords present in the set will be removed from the search query.
+	Stopwords *string `json:"stopwords,omitempty" url:"stopwords,omitempty"`
 
 	// 2. Filter params
 
 	// FilterBy Filter conditions for refining youropen api validator search
 	// results. Separate multiple conditions with &&.
-	FilterBy *string `url:"filter_by,omitmepty"`
+	FilterBy *string `json:"filter_by,omitempty" url:"filter_by,omitmepty"`
 
 	// 3. Ranking and Sorting params
 
 	// QueryByWeights The relative weight to give each `query_by` field when
 	// ranking results. This can be used to boost fields in priority, when
 	// looking for matches. Multiple fields are separated with a comma.
-	QueryByWeights *string `url:"query_by_weights,omitempty"`
+	QueryByWeights *string `json:"query_by_weights,omitempty" url:"query_by_weights,omitempty"`
 
 	// In a multi-field matching context, this parameter determines how the 
 	// representative text match score of a record is calculated.
 	// Possible values: `max_score` (default) or `max_weight`.
-	TextMatchType *string `url:"text_match_type,omitempty"`
+	TextMatchType *string `json:"text_match_type,omitempty" url:"text_match_type,omitempty"`
 
 	// SortBy A list of numerical fields and their corresponding sort orders
 	// that will be used for ordering your results. Up to 3 sort fields can be
@@ -848,15 +902,15 @@ type SearchParameters struct {
 	// `_text_match` field that you can use in the list of sorting fields. If no
 	// `sort_by` parameter is specified, results are sorted by
 	// `_text_match:desc,default_sorting_field:desc`
-	SortBy *string `url:"sort_by,omitempty"`
+	SortBy *string `json:"sort_by,omitempty" url:"sort_by,omitempty"`
 
 	// PrioritizeExactMatch Set this parameter to true to ensure that an exact
 	// match is ranked above the others
-	PrioritizeExactMatch *bool `url:"prioritize_exact_match,omitempty"`
+	PrioritizeExactMatch *bool `json:"prioritize_exact_match,omitempty" url:"prioritize_exact_match,omitempty"`
 
 	// PrioritizeTokenPosition Make Typesense prioritize documents where the
 	// query words appear earlier in the text.
-	PrioritizeTokenPosition *bool `url:"prioritize_token_position,omitempty"`
+	PrioritizeTokenPosition *bool `json:"prioritize_token_position,omitempty" url:"prioritize_token_position,omitempty"`
 
 	// PinnedHits A list of records to unconditionally include in the search
 	// results at specific positions. An example use case would be to feature or
@@ -866,184 +920,200 @@ type SearchParameters struct {
 	// `123:1,456:5`. You could also use the Overrides feature to override
 	// search results based on rules. Overrides are applied first, followed by
 	// `pinned_hits` and  finally `hidden_hits`.
-	PinnedHits *string `url:"pinned_hits,omitempty"`
+	PinnedHits *string `json:"pinned_hits,omitempty" url:"pinned_hits,omitempty"`
 
 	// HiddenHits A list of records to unconditionally hide from search results.
 	// A list of `record_id`s to hide. Eg: to hide records with IDs 123 and 456,
 	// you'd specify `123,456`. You could also use the Overrides feature to
 	// override search results based on rules. Overrides are applied first,
 	// followed by `pinned_hits` and finally `hidden_hits`.
-	HiddenHits *string `url:"hidden_hits,omitempty"`
+	HiddenHits *string `json:"hidden_hits,omitempty" url:"hidden_hits,omitempty"`
 
 	// EnableOverrides If you have some overrides defined but want to disable
 	// all of them during query time, you can do that by setting this parameter
 	// to false
-	EnableOverrides *bool `url:"enable_overrides,omitmepty"`
+	EnableOverrides *bool `json:"enable_overrides,omitempty" url:"enable_overrides,omitmepty"`
 
 	// 4. Pagination params
 
 	// Page Results from this specific page number would be fetched.
-	Page *int `url:"page,omitempty"`
+	Page *int `json:"page,omitempty" url:"page,omitempty"`
 
 	// PerPage Number of results to fetch per page. Default: 10
-	PerPage *int `url:"per_page,omitempty"`
+	PerPage *int `json:"per_page,omitempty" url:"per_page,omitempty"`
 	
 	// Identifies the starting point to return hits from a result set. 
 	// Can be used as an alternative to the page parameter.
-	Offset *int `url:"offset,omitempty"`
+	Offset *int `json:"offset,omitempty" url:"offset,omitempty"`
 
 	// Number of hits to fetch. Can be used as an alternative to the per_page 
 	// parameter. Default: 10
-	Limit *int `url:"limit,omitempty"`
+	Limit *int `json:"limit,omitempty" url:"limit,omitempty"`
 
 	// 5. Faceting params
 
 	// FacetBy A list of fields that will be used for faceting your results on.
 	// Separate multiple fields with a comma.
-	FacetBy *string `url:"facet_by,omitempty"`
+	FacetBy *string `json:"facet_by,omitempty" url:"facet_by,omitempty"`
 
 	// MaxFacetValues Maximum number of facet values to be returned.
-	MaxFacetValues *int `url:"max_facet_values,omitempty"`
+	MaxFacetValues *int `json:"max_facet_values,omitempty" url:"max_facet_values,omitempty"`
 
 	// FacetQuery Facet values that are returned can now be filtered via this
 	// parameter. The matching facet text is also highlighted. For example, when
 	// faceting by `category`, you can set `facet_query=category:shoe` to return
 	// only facet values that contain the prefix "shoe".
-	FacetQuery *string `url:"facet_query,omitempty"`
+	FacetQuery *string `json:"facet_query,omitempty" url:"facet_query,omitempty"`
 
-	FacetQueryNumTypes *int `url:"facet_query_num_typos,omitempty"`
+	FacetQueryNumTypes *int `json:"facet_query_num_typos,omitempty" url:"facet_query_num_typos,omitempty"`
 
 	// 6. Grouping params
 
 	// GroupBy You can aggregate search results into groups or buckets by
 	// specify one or more `group_by` fields. Separate multiple fields with a
 	// comma. To group on a particular field, it must be a faceted field.
-	GroupBy *string `url:"group_by,omitempty"`
+	GroupBy *string `json:"group_by,omitempty" url:"group_by,omitempty"`
 
 	// GroupLimit Maximum number of hits to be returned for every group. If the
 	// `group_limit` is set as `K` then only the top K hits in each group are
 	// returned in the response. Default: 3
-	GroupLimit *int `url:"group_limit,omitempty"`
+	GroupLimit *int `json:"group_limit,omitempty" url:"group_limit,omitempty"`
 
 	// 7. Result params
 
 	// IncludeFields List of fields from the document to include in the search
 	// result
-	IncludeFields *string `url:"include_fields,omitempty"`
+	IncludeFields *string `json:"include_fields,omitempty" url:"include_fields,omitempty"`
 
 	// ExcludeFields List of fields from the document to exclude in the search
 	// result
-	ExcludeFields *string `url:"exclude_fields,omitempty"`
+	ExcludeFields *string `json:"exclude_fields,omitempty" url:"exclude_fields,omitempty"`
 
 	// HighlightFields A list of custom fields that must be highlighted even if
 	// you don't query  for them
-	HighlightFields *string `url:"highlight_fields,omitempty"`
+	HighlightFields *string `json:"highlight_fields,omitempty" url:"highlight_fields,omitempty"`
 
 	// HighlightFullFields List of fields which should be highlighted fully
 	// without snippeting
-	HighlightFullFields *string `url:"highlight_full_fields,omitempty"`
+	HighlightFullFields *string `json:"highlight_full_fields,omitempty" url:"highlight_full_fields,omitempty"`
 
 	// HighlightAffixNumTokens The number of tokens that should surround the
 	// highlighted text on each side. Default: 4
-	HighlightAffixNumTokens *int `url:"highlight_affix_num_tokens,omitempty"`
+	HighlightAffixNumTokens *int `json:"highlight_affix_num_tokens,omitempty" url:"highlight_affix_num_tokens,omitempty"`
 
 	// HighlightStartTag The start tag used for the highlighted snippets.
 	// Default: `<mark>`
-	HighlightStartTag *string `url:"highlight_start_tag,omitempty"`
+	HighlightStartTag *string `json:"highlight_start_tag,omitempty" url:"highlight_start_tag,omitempty"`
 
 	// HighlightEndTag The end tag used for the highlighted snippets. 
 	// Default: `</mark>`
-	HighlightEndTag *string `url:"highlight_end_tag,omitempty"`
+	HighlightEndTag *string `json:"highlight_end_tag,omitempty" url:"highlight_end_tag,omitempty"`
 
 	// EnableHighlightV1 Flag for enabling/disabling the deprecated, 
 	// old highlight structure in the response. Default: true
-	EnableHighlightV1 *bool `url:"enable_highlight_v1,omitempty"`
+	EnableHighlightV1 *bool `json:"enable_highlight_v1,omitempty" url:"enable_highlight_v1,omitempty"`
 
 	// SnippetThreshold Field values under this length will be fully
 	// highlighted, instead of showing a snippet of relevant portion. Default: 30
-	SnippetThreshold *int `url:"snippet_threshold,omitempty"`
+	SnippetThreshold *int `json:"snippet_threshold,omitempty" url:"snippet_threshold,omitempty"`
 
 	// Maximum number of hits that can be fetched from the collection. 
 	// `page` * `per_page` should be less than this number for the search request 
 	// to return results. Default: no limit
-	LimitHits *int `url:"limit_hits,omitempty"`
+	LimitHits *int `json:"limit_hits,omitempty" url:"limit_hits,omitempty"`
 
 	// SearchCutoffMs Typesense will attempt to return results early if the
 	// cutoff time has elapsed.  This is not a strict guarantee and facet
 	// computation is not bound by this parameter.
-	SearchCutoffMs *int `url:"search_cutoff_ms,omitempty"`
+	SearchCutoffMs *int `json:"search_cutoff_ms,omitempty" url:"search_cutoff_ms,omitempty"`
 
 	// MaxCandidates Control the number of words that Typesense considers for
 	// typo and prefix searching.
-	MaxCandidates *int `url:"max_candidates,omitempty"`
+	MaxCandidates *int `json:"max_candidates,omitempty" url:"max_candidates,omitempty"`
 
 	// ExhaustiveSearch Setting this to true will make Typesense consider all
 	// prefixes and typo  corrections of the words in the query without stopping
 	// early when enough results are found  (drop_tokens_threshold and
 	// typo_tokens_threshold configurations are ignored).
-	ExhaustiveSearch *bool `url:"exhaustive_search,omitempty"`
+	ExhaustiveSearch *bool `json:"exhaustive_search,omitempty" url:"exhaustive_search,omitempty"`
 
 	// 8. Type-Tolerance params
 
 	// NumTypos The number of typographical errors (1 or 2) that would be tolerated. 
 	// Default: 2
-	NumTypos *string `url:"num_typos,omitempty"`
+	NumTypos *string `json:"num_typos,omitempty" url:"num_typos,omitempty"`
 
 	// MinLen1typo Minimum word length for 1-typo correction to be applied.  
 	// The value of num_typos is still treated as the maximum allowed typos.
-	MinLen1typo *int `url:"min_len_1typo,omitempty"`
+	MinLen1typo *int `json:"min_len_1typo,omitempty" url:"min_len_1typo,omitempty"`
 
 	// MinLen2typo Minimum word length for 2-typo correction to be applied.  
 	// The value of num_typos is still treated as the maximum allowed typos.
-	MinLen2typo *int `url:"min_len_2type,omitempty"`
+	MinLen2typo *int `json:"min_len_2typo,omitempty" url:"min_len_2type,omitempty"`
 
 	// SplitJoinTokens Treat space as typo: search for q=basket ball if
 	// q=basketball is not found or vice-versa. Splitting/joining of tokens will
 	// only be attempted if the original query produces no results. To always
 	// trigger this behavior, set value to `always`. To disable, set value to
 	// `off`. Default is `fallback`.
-	SplitJoinTokens *string `url:"split_join_tokes,omitempty"`
+	SplitJoinTokens *string `json:"split_join_tokens,omitempty" url:"split_join_tokes,omitempty"`
 
 	// TypoTokensThreshold If the number of results found for a specific query
 	// is less than this number, Typesense will attempt to look for tokens with
 	// more typos until enough results are found. Default: 100
-	TypoTokensThreshold *int `url:"typo_tokens_threshold,omitempty"`
+	TypoTokensThreshold *int `json:"typo_tokens_threshold,omitempty" url:"typo_tokens_threshold,omitempty"`
 
 	// DropTokensThreshold If the number of results found for a specific query
 	// is less than this number, Typesense will attempt to drop the tokens in
 	// the query until enough results are found. Tokens that have the least
 	// individual hits are dropped first. Set to 0 to disable. Default: 10
-	DropTokensThreshold *int `url:"drop_tokens_threshold,omitempty"`
+	DropTokensThreshold *int `json:"drop_tokens_threshold,omitempty" url:"drop_tokens_threshold,omitempty"`
 
 	// 9. Caching params
 
 	// UseCache Enable server side caching of search query results. 
 	// By default, caching is disabled.
-	UseCache *bool `url:"use_cache,omitempty"`
+	UseCache *bool `json:"use_cache,omitempty" url:"use_cache,omitempty"`
 
 	// CacheTtl The duration (in seconds) that determines how long the search
 	// query is cached. This value can be set on a per-query basis. Default: 60.
-	CacheTtl *int `url:"cache_ttl,omitempty"`
+	CacheTtl *int `json:"cache_ttl,omitempty" url:"cache_ttl,omitempty"`
 
 	// 10. Remote embedding params
 
 	// RemoteEmbeddingNumTries Number of times to retry fetching remote
 	// embeddings.
-	RemoteEmbeddingNumTries *int `url:"remote_embedding_num_tries,omitempty"`
+	RemoteEmbeddingNumTries *int `json:"remote_embedding_num_tries,omitempty" url:"remote_embedding_num_tries,omitempty"`
 
 	// RemoteEmbeddingTimeoutMs Timeout (in milliseconds) for fetching remote
 	// embeddings.
-	RemoteEmbeddingTimeoutMs *int `url:"remote_embedding_timeout_ms,omitempty"`
+	RemoteEmbeddingTimeoutMs *int `json:"remote_embedding_timeout_ms,omitempty" url:"remote_embedding_timeout_ms,omitempty"`
+
+	// 11. Conversation params
+
+	// Conversation Enable conversational search. The answer generated by the
+	// conversation model is returned in SearchResult.Conversation.
+	Conversation *bool `json:"conversation,omitempty" url:"conversation,omitempty"`
+
+	// ConversationModelId The id of the conversation model used to answer
+	// the query.
+	ConversationModelId *string `json:"conversation_model_id,omitempty" url:"conversation_model_id,omitempty"`
 
-	// 11. Other params
+	// ConversationId The id of a previous conversation to continue.
+	ConversationId *string `json:"conversation_id,omitempty" url:"conversation_id,omitempty"`
+
+	// 12. Other params
 
 	// VectorQuery Vector query expression for fetching documents "closest" to a
 	// given query/document vector.
-	VectorQuery *string `url:"vector_query,omitempty"`
+	VectorQuery *string `json:"vector_query,omitempty" url:"vector_query,omitempty"`
 }
 
 // SearchResult defines model for SearchResult.
 type SearchResult struct {
+	// Conversation The answer generated for a conversational search
+	Conversation *SearchResultConversation `json:"conversation,omitempty"`
+
 	FacetCounts []*FacetCounts `json:"facet_counts,omitempty"`
 
 	// Found The number of documents found
@@ -1072,6 +1142,22 @@ type SearchResult struct {
 	SearchTimeMs *int `json:"search_time_ms,omitempty"`
 }
 
+// SearchResultConversation defines model for SearchResultConversation.
+type SearchResultConversation struct {
+	// Answer The answer generated by the conversation model
+	Answer string `json:"answer"`
+
+	// ConversationHistory The messages exchanged in the conversation so far
+	ConversationHistory []map[string]interface{} `json:"conversation_history"`
+
+	// ConversationId The id to pass as conversation_id to continue the
+	// conversation
+	ConversationId string `json:"conversation_id"`
+
+	// Query The query the answer was generated for
+	Query string `json:"query"`
+}
+
 // SearchResultHit defines model for SearchResultHit.
 type SearchResultHit struct {
 	// Document Can be any key-value pair
@@ -1127,6 +1213,31 @@ type SearchSynonymsResponse struct {
 	Synonyms []*SearchSynonym `json:"synonyms"`
 }
 
+// StopwordsSet defines model for StopwordsSet.
+type StopwordsSet struct {
+	Id string `json:"id"`
+
+	// Locale Locale of the stopwords, e.g. `en`.
+	Locale *string `json:"locale,omitempty"`
+
+	// Stopwords Array of words removed from search queries using the set.
+	Stopwords []string `json:"stopwords"`
+}
+
+// StopwordsSetSchema defines model for StopwordsSetSchema.
+type StopwordsSetSchema struct {
+	// Locale Locale of the stopwords, e.g. `en`.
+	Locale *string `json:"locale,omitempty"`
+
+	// Stopwords Array of words removed from search queries using the set.
+	Stopwords []string `json:"stopwords"`
+}
+
+// StopwordsSetsResponse defines model for StopwordsSetsResponse.
+type StopwordsSetsResponse struct {
+	Stopwords []*StopwordsSet `json:"stopwords"`
+}
+
 // SuccessStatus defines model for SuccessStatus.
 type SuccessStatus struct {
 	Success bool `json:"success"`
@@ -1187,7 +1298,7 @@ type MultiSearchParams struct {
 type TakeSnapshotParams struct {
 	// SnapshotPath The directory on the server where the snapshot should be
 	// saved.
-	SnapshotPath string `form:"snapshot_path" json:"snapshot_path"`
+	SnapshotPath string `url:"snapshot_path" json:"snapshot_path"`
 }
 
 // UpsertAliasJSONRequestBody defines body for UpsertAlias for application/json
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/google/go-querystring/query"
 )
@@ -31,7 +32,9 @@ var errNonNilContext = errors.New("context must be non-nil")
 type Client struct {
 	client    *http.Client
 	serverURL *url.URL
-	apiKey    string
+
+	mu          sync.RWMutex
+	credentials CredentialProvider
 
 	common service
 
@@ -47,9 +50,21 @@ type Client struct {
 	AnalyticsEvents *AnalyticsEventsService
 	Presets         *PresetsService
 	Synonyms        *SynonymsService
+	Stopwords       *StopwordsService
+	Conversations   *ConversationsService
 }
 
 func NewClient(httpClient *http.Client, serverURL, apiKey string) (*Client, error) {
+	if apiKey == "" {
+		return nil, errors.New("apiKey is required")
+	}
+	return NewClientWithCredentials(httpClient, serverURL, StaticCredentials(apiKey))
+}
+
+// NewClientWithCredentials returns a Client that gets the API key for each
+// request from credentials, so that a rotated key is picked up without
+// creating a new client.
+func NewClientWithCredentials(httpClient *http.Client, serverURL string, credentials CredentialProvider) (*Client, error) {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
@@ -65,14 +80,14 @@ func NewClient(httpClient *http.Client, serverURL, apiKey string) (*Client, erro
 		parsedURL, _ = url.Parse(defaultServerURL)
 	}
 
-	if apiKey == "" {
-		return nil, errors.New("apiKey is required")
+	if credentials == nil {
+		return nil, errors.New("credentials are required")
 	}
 
 	c := &Client{
-		client:    httpClient,
-		serverURL: parsedURL,
-		apiKey:    apiKey,
+		client:      httpClient,
+		serverURL:   parsedURL,
+		credentials: credentials,
 	}
 
 	c.common.client = c
@@ -88,10 +103,40 @@ func NewClient(httpClient *http.Client, serverURL, apiKey string) (*Client, erro
 	c.AnalyticsEvents = (*AnalyticsEventsService)(&c.common)
 	c.Presets = (*PresetsService)(&c.common)
 	c.Synonyms = (*SynonymsService)(&c.common)
+	c.Stopwords = (*StopwordsService)(&c.common)
+	c.Conversations = (*ConversationsService)(&c.common)
 
 	return c, nil
 }
 
+// APIKey returns the API key the client currently sends, or "" if its
+// CredentialProvider fails.
+func (c *Client) APIKey() string {
+	key, _ := c.Credentials().APIKey()
+	return key
+}
+
+// SetAPIKey replaces the credentials of the client with a static API key. It
+// is safe to call while requests are in flight; requests created afterwards
+// use the new key.
+func (c *Client) SetAPIKey(apiKey string) {
+	c.SetCredentials(StaticCredentials(apiKey))
+}
+
+// Credentials returns the CredentialProvider of the client.
+func (c *Client) Credentials() CredentialProvider {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.credentials
+}
+
+// SetCredentials replaces the CredentialProvider of the client.
+func (c *Client) SetCredentials(credentials CredentialProvider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.credentials = credentials
+}
+
 type service struct {
 	client *Client
 }
@@ -104,26 +149,29 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 		return nil, err
 	}
 
-	var buf io.ReadWriter
+	var buf io.Reader
 	if body != nil {
-		buf = &bytes.Buffer{}
+		enc := &bytes.Buffer{}
+		buf = enc
 		switch body := body.(type) {
+		case io.Reader:
+			buf = body
 		case []map[string]interface{}:
 			for _, item := range body {
-				err := json.NewEncoder(buf).Encode(item)
+				err := json.NewEncoder(enc).Encode(item)
 				if err != nil {
 					return nil, err
 				}
 			}
 		case *[]map[string]interface{}:
 			for _, item := range *body {
-				err := json.NewEncoder(buf).Encode(item)
+				err := json.NewEncoder(enc).Encode(item)
 				if err != nil {
 					return nil, err
 				}
 			}
 		default:
-			err := json.NewEncoder(buf).Encode(body)
+			err := json.NewEncoder(enc).Encode(body)
 			if err != nil {
 				return nil, err
 			}
@@ -139,7 +187,11 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	req.Header.Set(headerAPIKEy, c.apiKey)
+	key, err := c.Credentials().APIKey()
+	if err != nil {
+		return nil, fmt.Errorf("api key: %w", err)
+	}
+	req.Header.Set(headerAPIKEy, key)
 
 	for _, opt := range opts {
 		opt(req)
@@ -201,6 +253,12 @@ func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, err
 	req = req.WithContext(ctx)
 
 	resp, err := c.client.Do(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		if retry := c.refreshCredentials(req); retry != nil {
+			resp.Body.Close()
+			resp, err = c.client.Do(retry)
+		}
+	}
 	if err != nil {
 		select {
 		case <-ctx.Done():
@@ -218,6 +276,33 @@ func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, err
 	return resp, err
 }
 
+// refreshCredentials refreshes the credentials after req was rejected with
+// 401 and returns a copy of req with the new key, or nil if the key did not
+// change or req cannot be sent again.
+func (c *Client) refreshCredentials(req *http.Request) *http.Request {
+	credentials := c.Credentials()
+	r, ok := credentials.(CredentialRefresher)
+	if !ok || req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return nil
+	}
+	if err := r.Refresh(); err != nil {
+		return nil
+	}
+	key, err := credentials.APIKey()
+	if err != nil || key == req.Header.Get(headerAPIKEy) {
+		return nil
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil
+		}
+	}
+	retry.Header.Set(headerAPIKEy, key)
+	return retry
+}
+
 func extractApiError(r *http.Response) error {
 	if c := r.StatusCode; c == 200 || c == 201 {
 		return nil
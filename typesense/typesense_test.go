@@ -1,11 +1,16 @@
 package typesense
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const apiKey = "xyz"
@@ -40,3 +45,43 @@ func TestNewClient_CustomConfig(t *testing.T) {
 	assert.Equal(t, httpClient, c.client)
 	assert.Equal(t, serverURL, c.serverURL.String())
 }
+
+func TestClient_NewRequestBody(t *testing.T) {
+	c, _ := NewClient(nil, "", apiKey)
+
+	req, err := c.NewRequest("POST", "/collections/companies/documents/import", strings.NewReader("{\"id\": \"1\"}\n"))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(req.Body)
+	assert.Equal(t, "{\"id\": \"1\"}\n", string(body))
+
+	req, err = c.NewRequest("POST", "/collections/companies/documents/import", []map[string]interface{}{{"id": "1"}, {"id": "2"}})
+	require.NoError(t, err)
+	body, _ = io.ReadAll(req.Body)
+	assert.Equal(t, "{\"id\":\"1\"}\n{\"id\":\"2\"}\n", string(body))
+
+	req, err = c.NewRequest("POST", "/aliases/companies", &CollectionAliasSchema{CollectionName: "companies_v1"})
+	require.NoError(t, err)
+	body, _ = io.ReadAll(req.Body)
+	assert.Equal(t, "{\"collection_name\":\"companies_v1\"}\n", string(body))
+}
+
+func TestClient_SetAPIKey(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var got []string
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get(headerAPIKEy))
+		fmt.Fprint(w, `{"ok": true}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Meta.Health(ctx)
+	assert.NoError(t, err)
+	client.SetAPIKey("rotated")
+	_, err = client.Meta.Health(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "rotated", client.APIKey())
+	assert.Equal(t, []string{"xyz", "rotated"}, got)
+}
@@ -0,0 +1,292 @@
+package typesense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWatchInterval   = 5 * time.Second
+	defaultWatchTimeout    = 2 * time.Second
+	defaultMaxQueuedWrites = 1000
+	defaultEventBuffer     = 100
+)
+
+// Node is a node of a cluster.
+type Node struct {
+	// URL is the server URL of the node. It identifies the node in events.
+	URL string
+
+	// Meta is the MetaService of a client connected to the node only.
+	Meta MetaAPI
+
+	// Operations is the OperationsService of the same client. It is only
+	// needed by a SnapshotManager.
+	Operations OperationsAPI
+}
+
+// NewNodes returns a Node with its own Client for each of the urls.
+func NewNodes(httpClient *http.Client, apiKey string, urls ...string) ([]*Node, error) {
+	nodes := make([]*Node, len(urls))
+	for i, u := range urls {
+		c, err := NewClient(httpClient, u, apiKey)
+		if err != nil {
+			return nil, fmt.Errorf("node %s: %w", u, err)
+		}
+		nodes[i] = &Node{URL: u, Meta: c.Meta, Operations: c.Operations}
+	}
+	return nodes, nil
+}
+
+type NodeEventType string
+
+const (
+	// NodeUnhealthy is emitted when a node fails its health check or cannot
+	// be reached.
+	NodeUnhealthy NodeEventType = "node_unhealthy"
+
+	// NodeRecovered is emitted when an unhealthy node passes its health
+	// check again.
+	NodeRecovered NodeEventType = "node_recovered"
+
+	// LeaderChanged is emitted when a different node, or none, reports itself
+	// as the raft leader, including when the leader is first discovered.
+	LeaderChanged NodeEventType = "leader_changed"
+
+	// WriteLagHigh is emitted when the queued writes of a node exceed
+	// Watcher.MaxQueuedWrites. It is emitted again only after they dropped
+	// back below.
+	WriteLagHigh NodeEventType = "write_lag_high"
+)
+
+// NodeEvent is a change of the state of a node observed by a Watcher.
+type NodeEvent struct {
+	Type NodeEventType
+	Node string
+	Time time.Time
+
+	// Err is the failure that made the node unhealthy, nil if the node
+	// responded but reported itself unhealthy.
+	Err error
+
+	// Previous is the URL of the former leader for LeaderChanged events.
+	// Node is empty if no node is the leader anymore.
+	Previous string
+
+	// QueuedWrites is the number of queued writes for WriteLagHigh events.
+	QueuedWrites int
+}
+
+// NodeState is the state of a node as of the last poll of a Watcher.
+type NodeState struct {
+	URL            string
+	Healthy        bool
+	Err            error
+	State          RaftState
+	QueuedWrites   int
+	CommittedIndex int
+	Version        string
+	CheckedAt      time.Time
+}
+
+// Watcher polls the health, raft status and version of the nodes of a
+// cluster in the background and emits a NodeEvent whenever a node becomes
+// unhealthy, recovers, falls behind on writes or the leader changes. Leader
+// and Healthy return the current view of the cluster for node selection.
+type Watcher struct {
+	nodes []*Node
+
+	// Interval is the time between two polls of the nodes.
+	// Default: 5s
+	Interval time.Duration
+
+	// Timeout bounds the requests of one poll of a node.
+	// Default: 2s
+	Timeout time.Duration
+
+	// MaxQueuedWrites is the number of queued writes above which a node
+	// emits WriteLagHigh. Default: 1000
+	MaxQueuedWrites int
+
+	// OnEvent, if set, is called with each event from the watcher's
+	// goroutine, before the event is sent on Events.
+	OnEvent func(*NodeEvent)
+
+	events chan *NodeEvent
+
+	mu      sync.RWMutex
+	states  map[string]*NodeState
+	lagging map[string]bool
+	leader  string
+}
+
+func NewWatcher(nodes ...*Node) *Watcher {
+	w := &Watcher{
+		nodes:           nodes,
+		Interval:        defaultWatchInterval,
+		Timeout:         defaultWatchTimeout,
+		MaxQueuedWrites: defaultMaxQueuedWrites,
+		events:          make(chan *NodeEvent, defaultEventBuffer),
+		states:          make(map[string]*NodeState, len(nodes)),
+		lagging:         make(map[string]bool),
+	}
+	for _, n := range nodes {
+		w.states[n.URL] = &NodeState{URL: n.URL, Healthy: true}
+	}
+	return w
+}
+
+// Events returns the channel events are sent on. Events are dropped if the
+// channel's buffer is full, so a slow reader never stalls the watcher; use
+// OnEvent to observe every event.
+func (w *Watcher) Events() <-chan *NodeEvent {
+	return w.events
+}
+
+// Run polls the nodes every Interval until ctx is done, starting right away.
+// An Interval <= 0 is replaced by the default.
+func (w *Watcher) Run(ctx context.Context) error {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		w.Poll(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll queries all nodes concurrently, updates their state and emits the
+// resulting events.
+func (w *Watcher) Poll(ctx context.Context) {
+	timeout := w.Timeout
+	if timeout <= 0 {
+		timeout = defaultWatchTimeout
+	}
+	states := make([]*NodeState, len(w.nodes))
+	var wg sync.WaitGroup
+	for i, n := range w.nodes {
+		wg.Add(1)
+		go func(i int, n *Node) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			states[i] = w.check(ctx, n)
+		}(i, n)
+	}
+	wg.Wait()
+
+	var events []*NodeEvent
+	now := time.Now()
+
+	w.mu.Lock()
+	leader := ""
+	for _, s := range states {
+		prev := w.states[s.URL]
+		switch {
+		case prev.Healthy && !s.Healthy:
+			events = append(events, &NodeEvent{Type: NodeUnhealthy, Node: s.URL, Time: now, Err: s.Err})
+		case !prev.Healthy && s.Healthy:
+			events = append(events, &NodeEvent{Type: NodeRecovered, Node: s.URL, Time: now})
+		}
+
+		lagging := s.QueuedWrites > w.MaxQueuedWrites
+		if lagging && !w.lagging[s.URL] {
+			events = append(events, &NodeEvent{Type: WriteLagHigh, Node: s.URL, Time: now, QueuedWrites: s.QueuedWrites})
+		}
+		w.lagging[s.URL] = lagging
+
+		if s.Healthy && s.State == RaftLeader && leader == "" {
+			leader = s.URL
+		}
+		w.states[s.URL] = s
+	}
+	if leader != w.leader {
+		events = append(events, &NodeEvent{Type: LeaderChanged, Node: leader, Previous: w.leader, Time: now})
+		w.leader = leader
+	}
+	w.mu.Unlock()
+
+	for _, ev := range events {
+		w.emit(ev)
+	}
+}
+
+func (w *Watcher) check(ctx context.Context, n *Node) *NodeState {
+	s := &NodeState{URL: n.URL, CheckedAt: time.Now()}
+
+	health, err := n.Meta.Health(ctx)
+	if err != nil {
+		s.Err = err
+		return s
+	}
+	s.Healthy = health.Ok
+
+	if status, err := n.Meta.Status(ctx); err == nil {
+		s.State = status.State
+		s.QueuedWrites = status.QueuedWrites
+		s.CommittedIndex = status.CommittedIndex
+	}
+	if debug, err := n.Meta.Debug(ctx); err == nil {
+		s.Version = debug.Version
+		if s.State == RaftUnknown {
+			// servers without /status
+			s.State = debug.State
+		}
+	}
+	return s
+}
+
+func (w *Watcher) emit(ev *NodeEvent) {
+	if w.OnEvent != nil {
+		w.OnEvent(ev)
+	}
+	select {
+	case w.events <- ev:
+	default:
+	}
+}
+
+// Leader returns the URL of the healthy node that reported itself as the raft
+// leader in the last poll, or "" if there is none.
+func (w *Watcher) Leader() string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.leader
+}
+
+// Healthy returns the URLs of the nodes that passed their health check in the
+// last poll, in the order the nodes were given. Before the first poll, all
+// nodes are considered healthy.
+func (w *Watcher) Healthy() []string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	var res []string
+	for _, n := range w.nodes {
+		if w.states[n.URL].Healthy {
+			res = append(res, n.URL)
+		}
+	}
+	return res
+}
+
+// States returns the state of each node as of the last poll, in the order
+// the nodes were given.
+func (w *Watcher) States() []NodeState {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	res := make([]NodeState, len(w.nodes))
+	for i, n := range w.nodes {
+		res[i] = *w.states[n.URL]
+	}
+	return res
+}
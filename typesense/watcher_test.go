@@ -0,0 +1,120 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMeta is a MetaAPI reporting a fixed health and status.
+type fakeMeta struct {
+	MetaAPI
+
+	mu     sync.Mutex
+	err    error
+	ok     bool
+	status NodeStatus
+}
+
+func (m *fakeMeta) set(ok bool, err error, state RaftState, queuedWrites int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ok, m.err = ok, err
+	m.status = NodeStatus{State: state, QueuedWrites: queuedWrites}
+}
+
+func (m *fakeMeta) Health(ctx context.Context) (*HealthStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &HealthStatus{Ok: m.ok}, nil
+}
+
+func (m *fakeMeta) Status(ctx context.Context) (*NodeStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	status := m.status
+	return &status, nil
+}
+
+func (m *fakeMeta) Debug(ctx context.Context) (*Debug, error) {
+	return &Debug{Version: "0.25.0"}, nil
+}
+
+func TestWatcher(t *testing.T) {
+	node1, node2 := &fakeMeta{}, &fakeMeta{}
+	node1.set(true, nil, RaftLeader, 0)
+	node2.set(true, nil, RaftFollower, 0)
+
+	var events []*NodeEvent
+	w := NewWatcher(&Node{URL: "http://node1:8108", Meta: node1}, &Node{URL: "http://node2:8108", Meta: node2})
+	w.MaxQueuedWrites = 10
+	w.OnEvent = func(ev *NodeEvent) { events = append(events, ev) }
+	ctx := context.Background()
+
+	type event struct {
+		Type     NodeEventType
+		Node     string
+		Previous string
+	}
+	poll := func() []event {
+		events = nil
+		w.Poll(ctx)
+		var res []event
+		for _, ev := range events {
+			res = append(res, event{ev.Type, ev.Node, ev.Previous})
+		}
+		return res
+	}
+
+	assert.Equal(t, []event{{LeaderChanged, "http://node1:8108", ""}}, poll())
+	assert.Equal(t, "http://node1:8108", w.Leader())
+	assert.Equal(t, "0.25.0", w.States()[0].Version)
+	assert.Empty(t, poll())
+
+	// the leader goes down and node2 is elected
+	down := errors.New("connection refused")
+	node1.set(false, down, RaftUnknown, 0)
+	node2.set(true, nil, RaftLeader, 25)
+	assert.Equal(t, []event{
+		{NodeUnhealthy, "http://node1:8108", ""},
+		{WriteLagHigh, "http://node2:8108", ""},
+		{LeaderChanged, "http://node2:8108", "http://node1:8108"},
+	}, poll())
+	assert.Equal(t, []string{"http://node2:8108"}, w.Healthy())
+	assert.Equal(t, down, w.States()[0].Err)
+
+	// write lag is only reported again after it recovered
+	assert.Empty(t, poll())
+	node2.set(true, nil, RaftLeader, 0)
+	assert.Empty(t, poll())
+
+	node1.set(true, nil, RaftFollower, 0)
+	assert.Equal(t, []event{{NodeRecovered, "http://node1:8108", ""}}, poll())
+	assert.Equal(t, []string{"http://node1:8108", "http://node2:8108"}, w.Healthy())
+
+	// events are also sent on the channel
+	assert.Len(t, w.Events(), 5)
+	ev := <-w.Events()
+	assert.Equal(t, LeaderChanged, ev.Type)
+}
+
+func TestWatcher_RunInvalidConfig(t *testing.T) {
+	node := &fakeMeta{}
+	node.set(true, nil, RaftLeader, 0)
+	w := NewWatcher(&Node{URL: "http://node1:8108", Meta: node})
+	w.Interval = 0
+	w.Timeout = -time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, w.Run(ctx), context.DeadlineExceeded)
+	assert.Equal(t, []string{"http://node1:8108"}, w.Healthy())
+	assert.Equal(t, "http://node1:8108", w.Leader())
+}
@@ -0,0 +1,435 @@
+package typesensetest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+type collection struct {
+	schema    typesense.CollectionSchema
+	createdAt int64
+
+	docs   map[string]map[string]interface{}
+	order  []string
+	nextID int
+
+	synonyms map[string]*typesense.SearchSynonym
+}
+
+func (c *collection) info() *typesense.Collection {
+	n := int64(len(c.docs))
+	return &typesense.Collection{
+		CreatedAt:           &c.createdAt,
+		DefaultSortingField: c.schema.DefaultSortingField,
+		EnableNestedFields:  c.schema.EnableNestedFields,
+		Fields:              c.schema.Fields,
+		Name:                c.schema.Name,
+		NumDocuments:        &n,
+		SymbolsToIndex:      c.schema.SymbolsToIndex,
+		TokenSeparators:     c.schema.TokenSeparators,
+	}
+}
+
+// documents returns the documents in insertion order.
+func (c *collection) documents() []map[string]interface{} {
+	docs := make([]map[string]interface{}, 0, len(c.docs))
+	for _, id := range c.order {
+		if doc, ok := c.docs[id]; ok {
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
+
+func (c *collection) remove(id string) {
+	delete(c.docs, id)
+	for i, v := range c.order {
+		if v == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// lookup resolves name through the aliases.
+func (s *Server) lookup(name string) *collection {
+	if target, ok := s.aliases[name]; ok {
+		name = target
+	}
+	return s.collections[name]
+}
+
+func (s *Server) serveCollections(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 || parts[0] == "" {
+		switch r.Method {
+		case "GET":
+			names := make([]string, 0, len(s.collections))
+			for name := range s.collections {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			res := make([]*typesense.Collection, 0, len(names))
+			for _, name := range names {
+				res = append(res, s.collections[name].info())
+			}
+			writeJSON(w, http.StatusOK, res)
+		case "POST":
+			s.createCollection(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	c := s.lookup(parts[0])
+	if c == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Collection `%s` not found.", parts[0]))
+		return
+	}
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case "GET":
+			writeJSON(w, http.StatusOK, c.info())
+		case "PATCH":
+			s.updateCollection(w, r, c)
+		case "DELETE":
+			delete(s.collections, c.schema.Name)
+			writeJSON(w, http.StatusOK, c.info())
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	switch parts[1] {
+	case "documents":
+		s.serveDocuments(w, r, c, parts[2:])
+	case "synonyms":
+		s.serveSynonyms(w, r, c, parts[2:])
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) createCollection(w http.ResponseWriter, r *http.Request) {
+	var schema typesense.CollectionSchema
+	if err := decode(r, &schema); err != nil {
+		writeError(w, http.StatusBadRequest, "Bad JSON.")
+		return
+	}
+	if schema.Name == "" {
+		writeError(w, http.StatusBadRequest, "Parameter `name` is required.")
+		return
+	}
+	if _, ok := s.collections[schema.Name]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("A collection with name `%s` already exists.", schema.Name))
+		return
+	}
+	if err := schema.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c := &collection{
+		schema:    schema,
+		createdAt: time.Now().Unix(),
+		docs:      make(map[string]map[string]interface{}),
+		synonyms:  make(map[string]*typesense.SearchSynonym),
+	}
+	s.collections[schema.Name] = c
+	writeJSON(w, http.StatusCreated, c.info())
+}
+
+func (s *Server) updateCollection(w http.ResponseWriter, r *http.Request, c *collection) {
+	var update typesense.CollectionUpdateSchema
+	if err := decode(r, &update); err != nil {
+		writeError(w, http.StatusBadRequest, "Bad JSON.")
+		return
+	}
+	for _, f := range update.Fields {
+		if f.Drop != nil && *f.Drop {
+			fields := c.schema.Fields[:0]
+			for _, existing := range c.schema.Fields {
+				if existing.Name != f.Name {
+					fields = append(fields, existing)
+				}
+			}
+			c.schema.Fields = fields
+			continue
+		}
+		if field(&c.schema, f.Name) != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Field `%s` is already part of the schema: To change this field, drop it first before adding it back to the schema.", f.Name))
+			return
+		}
+		c.schema.Fields = append(c.schema.Fields, f)
+	}
+	writeJSON(w, http.StatusOK, &update)
+}
+
+func field(schema *typesense.CollectionSchema, name string) *typesense.Field {
+	for _, f := range schema.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) serveDocuments(w http.ResponseWriter, r *http.Request, c *collection, parts []string) {
+	if len(parts) == 0 || parts[0] == "" {
+		switch r.Method {
+		case "POST":
+			var doc map[string]interface{}
+			if err := decode(r, &doc); err != nil {
+				writeError(w, http.StatusBadRequest, "Bad JSON.")
+				return
+			}
+			res, status, err := c.write(doc, r.URL.Query().Get("action"))
+			if err != nil {
+				writeError(w, status, err.Error())
+				return
+			}
+			writeJSON(w, http.StatusCreated, res)
+		case "PATCH":
+			s.updateByQuery(w, r, c)
+		case "DELETE":
+			s.deleteByQuery(w, r, c)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	switch parts[0] {
+	case "import":
+		if r.Method != "POST" {
+			methodNotAllowed(w)
+			return
+		}
+		s.importDocuments(w, r, c)
+		return
+	case "export":
+		if r.Method != "GET" {
+			methodNotAllowed(w)
+			return
+		}
+		s.exportDocuments(w, r, c)
+		return
+	case "search":
+		if r.Method != "GET" {
+			methodNotAllowed(w)
+			return
+		}
+		res, err := c.search(searchParamsFromQuery(r.URL.Query()))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, res)
+		return
+	}
+
+	id := parts[0]
+	doc, ok := c.docs[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Could not find a document with id: %s", id))
+		return
+	}
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, doc)
+	case "PATCH":
+		var patch map[string]interface{}
+		if err := decode(r, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, "Bad JSON.")
+			return
+		}
+		patch["id"] = id
+		res, status, err := c.write(patch, "update")
+		if err != nil {
+			writeError(w, status, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, res)
+	case "DELETE":
+		c.remove(id)
+		writeJSON(w, http.StatusOK, doc)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// write indexes doc according to the import action and returns the stored
+// document or the status code of the failure.
+func (c *collection) write(doc map[string]interface{}, action string) (map[string]interface{}, int, error) {
+	if action == "" {
+		action = "create"
+	}
+
+	var id string
+	switch v := doc["id"].(type) {
+	case nil:
+		if action == "update" {
+			return nil, http.StatusBadRequest, fmt.Errorf("For update, the `id` key must be provided.")
+		}
+		for {
+			id = strconv.Itoa(c.nextID)
+			c.nextID++
+			if _, ok := c.docs[id]; !ok {
+				break
+			}
+		}
+	case string:
+		id = v
+	default:
+		return nil, http.StatusBadRequest, fmt.Errorf("Document's `id` field should be a string.")
+	}
+
+	existing, exists := c.docs[id]
+	switch action {
+	case "create":
+		if exists {
+			return nil, http.StatusConflict, fmt.Errorf("A document with id %s already exists.", id)
+		}
+	case "update":
+		if !exists {
+			return nil, http.StatusNotFound, fmt.Errorf("Could not find a document with id: %s", id)
+		}
+	case "upsert", "emplace":
+	default:
+		return nil, http.StatusBadRequest, fmt.Errorf("Invalid action `%s`.", action)
+	}
+
+	stored := make(map[string]interface{})
+	if exists && (action == "update" || action == "emplace") {
+		for k, v := range existing {
+			stored[k] = v
+		}
+	}
+	for k, v := range doc {
+		stored[k] = v
+	}
+	stored["id"] = id
+
+	for _, f := range c.schema.Fields {
+		if f.Optional != nil && *f.Optional || f.Type == "auto" || f.Name == ".*" {
+			continue
+		}
+		if _, ok := stored[f.Name]; !ok {
+			return nil, http.StatusBadRequest, fmt.Errorf("Field `%s` has been declared in the schema, but is not found in the document.", f.Name)
+		}
+	}
+
+	if !exists {
+		c.order = append(c.order, id)
+	}
+	c.docs[id] = stored
+	return stored, http.StatusOK, nil
+}
+
+func (s *Server) importDocuments(w http.ResponseWriter, r *http.Request, c *collection) {
+	action := r.URL.Query().Get("action")
+
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var doc map[string]interface{}
+		if err := json.Unmarshal(line, &doc); err != nil {
+			enc.Encode(importFailure(http.StatusBadRequest, "Bad JSON.", line))
+			continue
+		}
+		if _, status, err := c.write(doc, action); err != nil {
+			enc.Encode(importFailure(status, err.Error(), line))
+			continue
+		}
+		enc.Encode(map[string]interface{}{"success": true})
+	}
+	if err := scanner.Err(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write(out.Bytes())
+}
+
+func importFailure(code int, message string, line []byte) map[string]interface{} {
+	return map[string]interface{}{
+		"success":  false,
+		"code":     code,
+		"error":    message,
+		"document": string(line),
+	}
+}
+
+func (s *Server) exportDocuments(w http.ResponseWriter, r *http.Request, c *collection) {
+	query := r.URL.Query()
+	docs, err := c.filter(query.Get("filter_by"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	for i, doc := range docs {
+		b, _ := json.Marshal(project(doc, query.Get("include_fields"), query.Get("exclude_fields")))
+		if i > 0 {
+			w.Write([]byte("\n"))
+		}
+		w.Write(b)
+	}
+}
+
+func (s *Server) updateByQuery(w http.ResponseWriter, r *http.Request, c *collection) {
+	docs, err := c.filter(r.URL.Query().Get("filter_by"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var patch map[string]interface{}
+	if err := decode(r, &patch); err != nil {
+		writeError(w, http.StatusBadRequest, "Bad JSON.")
+		return
+	}
+	for _, doc := range docs {
+		for k, v := range patch {
+			if k != "id" {
+				doc[k] = v
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, &typesense.UpdateByQueryResponse{NumUpdated: len(docs)})
+}
+
+func (s *Server) deleteByQuery(w http.ResponseWriter, r *http.Request, c *collection) {
+	filterBy := r.URL.Query().Get("filter_by")
+	if filterBy == "" {
+		writeError(w, http.StatusBadRequest, "Parameter `filter_by` must be provided.")
+		return
+	}
+	docs, err := c.filter(filterBy)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	for _, doc := range docs {
+		c.remove(doc["id"].(string))
+	}
+	n := len(docs)
+	writeJSON(w, http.StatusOK, &typesense.DeleteByQueryResponse{NumDeleted: &n})
+}
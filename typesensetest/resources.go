@@ -0,0 +1,198 @@
+package typesensetest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+func (s *Server) serveAliases(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 || parts[0] == "" {
+		if r.Method != "GET" {
+			methodNotAllowed(w)
+			return
+		}
+		names := make([]string, 0, len(s.aliases))
+		for name := range s.aliases {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		res := &typesense.CollectionAliasesResponse{Aliases: []*typesense.CollectionAlias{}}
+		for _, name := range names {
+			res.Aliases = append(res.Aliases, &typesense.CollectionAlias{Name: name, CollectionName: s.aliases[name]})
+		}
+		writeJSON(w, http.StatusOK, res)
+		return
+	}
+
+	name := parts[0]
+	switch r.Method {
+	case "PUT":
+		var body typesense.CollectionAliasSchema
+		if err := decode(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "Bad JSON.")
+			return
+		}
+		if _, ok := s.collections[body.CollectionName]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Collection `%s` not found.", body.CollectionName))
+			return
+		}
+		s.aliases[name] = body.CollectionName
+		writeJSON(w, http.StatusOK, &typesense.CollectionAlias{Name: name, CollectionName: body.CollectionName})
+	case "GET", "DELETE":
+		target, ok := s.aliases[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		if r.Method == "DELETE" {
+			delete(s.aliases, name)
+		}
+		writeJSON(w, http.StatusOK, &typesense.CollectionAlias{Name: name, CollectionName: target})
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) serveSynonyms(w http.ResponseWriter, r *http.Request, c *collection, parts []string) {
+	if len(parts) == 0 || parts[0] == "" {
+		if r.Method != "GET" {
+			methodNotAllowed(w)
+			return
+		}
+		ids := make([]string, 0, len(c.synonyms))
+		for id := range c.synonyms {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		res := &typesense.SearchSynonymsResponse{Synonyms: []*typesense.SearchSynonym{}}
+		for _, id := range ids {
+			res.Synonyms = append(res.Synonyms, c.synonyms[id])
+		}
+		writeJSON(w, http.StatusOK, res)
+		return
+	}
+
+	id := parts[0]
+	switch r.Method {
+	case "PUT":
+		var body typesense.SearchSynonymSchema
+		if err := decode(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "Bad JSON.")
+			return
+		}
+		if len(body.Synonyms) == 0 {
+			writeError(w, http.StatusBadRequest, "Could not find an array of `synonyms`")
+			return
+		}
+		synonym := &typesense.SearchSynonym{Id: &id, Root: body.Root, Synonyms: body.Synonyms}
+		c.synonyms[id] = synonym
+		writeJSON(w, http.StatusOK, synonym)
+	case "GET", "DELETE":
+		synonym, ok := c.synonyms[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Could not find that `id`.")
+			return
+		}
+		if r.Method == "GET" {
+			writeJSON(w, http.StatusOK, synonym)
+			return
+		}
+		delete(c.synonyms, id)
+		writeJSON(w, http.StatusOK, &typesense.DeleteSynonymResponse{ID: id})
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) serveKeys(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 || parts[0] == "" {
+		switch r.Method {
+		case "GET":
+			res := &typesense.ApiKeysResponse{Keys: []*typesense.ApiKey{}}
+			for _, k := range s.keys {
+				res.Keys = append(res.Keys, redact(k))
+			}
+			writeJSON(w, http.StatusOK, res)
+		case "POST":
+			s.createKey(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Key ID must be an integer.")
+		return
+	}
+	for i, k := range s.keys {
+		if *k.Id != id {
+			continue
+		}
+		switch r.Method {
+		case "GET":
+			writeJSON(w, http.StatusOK, redact(k))
+		case "DELETE":
+			s.keys = append(s.keys[:i], s.keys[i+1:]...)
+			writeJSON(w, http.StatusOK, map[string]int64{"id": id})
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+	writeError(w, http.StatusNotFound, "Key not found.")
+}
+
+func (s *Server) createKey(w http.ResponseWriter, r *http.Request) {
+	var body typesense.ApiKeySchema
+	if err := decode(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "Bad JSON.")
+		return
+	}
+	if len(body.Actions) == 0 {
+		writeError(w, http.StatusBadRequest, "Could not find a valid `actions` array.")
+		return
+	}
+	if len(body.Collections) == 0 {
+		writeError(w, http.StatusBadRequest, "Could not find a valid `collections` array.")
+		return
+	}
+
+	value := body.Value
+	if value == nil {
+		b := make([]byte, 16)
+		rand.Read(b)
+		v := hex.EncodeToString(b)
+		value = &v
+	}
+	s.nextKeyID++
+	id := s.nextKeyID
+	prefix := (*value)[:min(4, len(*value))]
+	key := &typesense.ApiKey{
+		Actions:     body.Actions,
+		Collections: body.Collections,
+		ExpiresAt:   body.ExpiresAt,
+		Id:          &id,
+		Value:       value,
+		ValuePrefix: &prefix,
+	}
+	if body.Description != nil {
+		key.Description = *body.Description
+	}
+	s.keys = append(s.keys, key)
+	writeJSON(w, http.StatusCreated, key)
+}
+
+// redact hides the key value as the server does once a key is created.
+func redact(k *typesense.ApiKey) *typesense.ApiKey {
+	res := *k
+	res.Value = nil
+	return &res
+}
@@ -0,0 +1,451 @@
+package typesensetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+type searchParams struct {
+	collection    string
+	q             string
+	queryBy       string
+	prefix        string
+	filterBy      string
+	sortBy        string
+	includeFields string
+	excludeFields string
+	page          int
+	perPage       int
+}
+
+func searchParamsFromQuery(query url.Values) *searchParams {
+	p := &searchParams{
+		q:             query.Get("q"),
+		queryBy:       query.Get("query_by"),
+		prefix:        query.Get("prefix"),
+		filterBy:      query.Get("filter_by"),
+		sortBy:        query.Get("sort_by"),
+		includeFields: query.Get("include_fields"),
+		excludeFields: query.Get("exclude_fields"),
+	}
+	p.page, _ = strconv.Atoi(query.Get("page"))
+	p.perPage, _ = strconv.Atoi(query.Get("per_page"))
+	return p
+}
+
+func (s *Server) serveMultiSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		methodNotAllowed(w)
+		return
+	}
+	var body struct {
+		Searches []map[string]interface{} `json:"searches"`
+	}
+	if err := decode(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "Bad JSON.")
+		return
+	}
+
+	results := make([]interface{}, 0, len(body.Searches))
+	for _, search := range body.Searches {
+		query := r.URL.Query()
+		for k, v := range search {
+			query.Set(k, fmt.Sprint(v))
+		}
+		p := searchParamsFromQuery(query)
+		p.collection = query.Get("collection")
+
+		c := s.lookup(p.collection)
+		if c == nil {
+			results = append(results, &typesense.SearchError{
+				Code:  http.StatusNotFound,
+				Error: fmt.Sprintf("Collection `%s` not found.", p.collection),
+			})
+			continue
+		}
+		res, err := c.search(p)
+		if err != nil {
+			results = append(results, &typesense.SearchError{Code: http.StatusBadRequest, Error: err.Error()})
+			continue
+		}
+		results = append(results, res)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"results": results})
+}
+
+type hit struct {
+	doc   map[string]interface{}
+	score int64
+	index int
+}
+
+func (c *collection) search(p *searchParams) (*typesense.SearchResult, error) {
+	if p.q == "" {
+		return nil, fmt.Errorf("Parameter `q` is required.")
+	}
+	var queryBy []string
+	if p.q != "*" {
+		if p.queryBy == "" {
+			return nil, fmt.Errorf("Parameter `query_by` is required.")
+		}
+		for _, name := range strings.Split(p.queryBy, ",") {
+			name = strings.TrimSpace(name)
+			if field(&c.schema, name) == nil {
+				return nil, fmt.Errorf("Could not find a field named `%s` in the schema.", name)
+			}
+			queryBy = append(queryBy, name)
+		}
+	}
+
+	docs, err := c.filter(p.filterBy)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := tokenize(p.q)
+	var hits []*hit
+	for i, doc := range docs {
+		if p.q == "*" {
+			hits = append(hits, &hit{doc: doc, index: i})
+			continue
+		}
+		score, ok := textMatch(doc, queryBy, tokens, p.prefix != "false")
+		if ok {
+			hits = append(hits, &hit{doc: doc, score: score, index: i})
+		}
+	}
+
+	if err := c.sort(hits, p.sortBy); err != nil {
+		return nil, err
+	}
+
+	page, perPage := p.page, p.perPage
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 10
+	}
+	if perPage > 250 {
+		perPage = 250
+	}
+
+	found := len(hits)
+	outOf := len(c.docs)
+	res := &typesense.SearchResult{
+		Found:        &found,
+		OutOf:        &outOf,
+		Page:         &page,
+		SearchTimeMs: new(int),
+		Hits:         []*typesense.SearchResultHit{},
+	}
+	start := (page - 1) * perPage
+	for i := start; i < len(hits) && i < start+perPage; i++ {
+		score := hits[i].score
+		res.Hits = append(res.Hits, &typesense.SearchResultHit{
+			Document:   project(hits[i].doc, p.includeFields, p.excludeFields),
+			Highlights: []*typesense.SearchHighlight{},
+			TextMatch:  &score,
+		})
+	}
+	return res, nil
+}
+
+// textMatch reports whether every query token matches a token of one of the
+// fields. Exact token matches score higher than prefix matches and matches
+// in earlier query_by fields score higher than in later ones.
+func textMatch(doc map[string]interface{}, queryBy []string, tokens []string, prefix bool) (int64, bool) {
+	var score int64
+	for i, token := range tokens {
+		last := i == len(tokens)-1
+		best := int64(0)
+		for j, name := range queryBy {
+			weight := int64(len(queryBy) - j)
+			for _, v := range values(doc[name]) {
+				for _, t := range tokenize(fmt.Sprint(v)) {
+					var s int64
+					switch {
+					case t == token:
+						s = 2 * weight
+					case prefix && last && strings.HasPrefix(t, token):
+						s = weight
+					}
+					if s > best {
+						best = s
+					}
+				}
+			}
+		}
+		if best == 0 {
+			return 0, false
+		}
+		score += best
+	}
+	return score, true
+}
+
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// values flattens array fields.
+func values(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	default:
+		return []interface{}{v}
+	}
+}
+
+func (c *collection) sort(hits []*hit, sortBy string) error {
+	type clause struct {
+		field string
+		desc  bool
+	}
+	var clauses []clause
+	if sortBy != "" {
+		for _, part := range strings.Split(sortBy, ",") {
+			name, dir, _ := strings.Cut(strings.TrimSpace(part), ":")
+			dir = strings.ToLower(dir)
+			if dir != "asc" && dir != "desc" {
+				return fmt.Errorf("Order direction of `%s` must be either `asc` or `desc`.", name)
+			}
+			if name != "_text_match" && field(&c.schema, name) == nil {
+				return fmt.Errorf("Could not find a field named `%s` in the schema for sorting.", name)
+			}
+			clauses = append(clauses, clause{field: name, desc: dir == "desc"})
+		}
+		if len(clauses) > 3 {
+			return fmt.Errorf("Only upto 3 sort_by fields can be specified.")
+		}
+	} else {
+		clauses = append(clauses, clause{field: "_text_match", desc: true})
+		if f := c.schema.DefaultSortingField; f != nil && *f != "" {
+			clauses = append(clauses, clause{field: *f, desc: true})
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		for _, cl := range clauses {
+			var cmp int
+			if cl.field == "_text_match" {
+				cmp = compareFloat(float64(hits[i].score), float64(hits[j].score))
+			} else {
+				cmp = compareValues(hits[i].doc[cl.field], hits[j].doc[cl.field])
+			}
+			if cmp == 0 {
+				continue
+			}
+			if cl.desc {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return hits[i].index < hits[j].index
+	})
+	return nil
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareValues(a, b interface{}) int {
+	af, aok := a.(float64)
+	bf, bok := b.(float64)
+	if aok && bok {
+		return compareFloat(af, bf)
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// condition is a single filter_by clause.
+type condition struct {
+	field string
+	op    string
+	vals  []string
+	min   string
+	max   string
+}
+
+func parseFilter(expr string) ([]*condition, error) {
+	var conds []*condition
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+	for _, clause := range strings.Split(expr, "&&") {
+		clause = strings.TrimSpace(clause)
+		name, rest, ok := strings.Cut(clause, ":")
+		if !ok {
+			return nil, fmt.Errorf("Could not parse the filter query.")
+		}
+		cond := &condition{field: strings.TrimSpace(name), op: ":"}
+		rest = strings.TrimSpace(rest)
+		for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+			if strings.HasPrefix(rest, op) {
+				cond.op = op
+				rest = strings.TrimSpace(strings.TrimPrefix(rest, op))
+				break
+			}
+		}
+		if strings.HasPrefix(rest, "[") && strings.HasSuffix(rest, "]") {
+			inner := rest[1 : len(rest)-1]
+			if lo, hi, ok := strings.Cut(inner, ".."); ok {
+				cond.op = ".."
+				cond.min, cond.max = strings.TrimSpace(lo), strings.TrimSpace(hi)
+			} else {
+				for _, v := range strings.Split(inner, ",") {
+					cond.vals = append(cond.vals, unquote(v))
+				}
+			}
+		} else {
+			cond.vals = []string{unquote(rest)}
+		}
+		conds = append(conds, cond)
+	}
+	return conds, nil
+}
+
+func unquote(s string) string {
+	return strings.Trim(strings.TrimSpace(s), "`")
+}
+
+// filter returns the documents matching every clause of expr.
+func (c *collection) filter(expr string) ([]map[string]interface{}, error) {
+	conds, err := parseFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	for _, cond := range conds {
+		if field(&c.schema, cond.field) == nil {
+			return nil, fmt.Errorf("Could not find a filter field named `%s` in the schema.", cond.field)
+		}
+	}
+
+	var res []map[string]interface{}
+	for _, doc := range c.documents() {
+		ok := true
+		for _, cond := range conds {
+			if !cond.match(doc[cond.field]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			res = append(res, doc)
+		}
+	}
+	return res, nil
+}
+
+func (cond *condition) match(v interface{}) bool {
+	vals := values(v)
+	if cond.op == "!=" {
+		for _, x := range vals {
+			if equal(x, cond.vals[0], true) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, x := range vals {
+		switch cond.op {
+		case ":", "=":
+			for _, want := range cond.vals {
+				if equal(x, want, cond.op == "=") {
+					return true
+				}
+			}
+		case "..":
+			f, ok := x.(float64)
+			lo, err1 := strconv.ParseFloat(cond.min, 64)
+			hi, err2 := strconv.ParseFloat(cond.max, 64)
+			if ok && err1 == nil && err2 == nil && f >= lo && f <= hi {
+				return true
+			}
+		default:
+			f, ok := x.(float64)
+			want, err := strconv.ParseFloat(cond.vals[0], 64)
+			if !ok || err != nil {
+				continue
+			}
+			switch cond.op {
+			case ">":
+				ok = f > want
+			case ">=":
+				ok = f >= want
+			case "<":
+				ok = f < want
+			case "<=":
+				ok = f <= want
+			}
+			if ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// equal compares a document value with a filter value. Without exact, string
+// values match case-insensitively.
+func equal(v interface{}, want string, exact bool) bool {
+	switch v := v.(type) {
+	case float64:
+		f, err := strconv.ParseFloat(want, 64)
+		return err == nil && f == v
+	case bool:
+		return strconv.FormatBool(v) == want
+	case string:
+		if exact {
+			return v == want
+		}
+		return strings.EqualFold(v, want)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b) == want
+	}
+}
+
+// project applies include_fields and exclude_fields to a document.
+func project(doc map[string]interface{}, include, exclude string) map[string]interface{} {
+	if include == "" && exclude == "" {
+		return doc
+	}
+	res := make(map[string]interface{})
+	if include != "" {
+		for _, name := range strings.Split(include, ",") {
+			name = strings.TrimSpace(name)
+			if v, ok := doc[name]; ok {
+				res[name] = v
+			}
+		}
+	} else {
+		for k, v := range doc {
+			res[k] = v
+		}
+	}
+	for _, name := range strings.Split(exclude, ",") {
+		delete(res, strings.TrimSpace(name))
+	}
+	return res
+}
@@ -0,0 +1,135 @@
+// Package typesensetest provides an in-memory fake Typesense server for unit
+// tests of code built on the typesense package.
+//
+// The fake implements collections, documents (CRUD, import, export, update
+// and delete by query), aliases, keys, synonyms and a simplified search:
+//
+//   - q matches documents where every query token equals a token of one of
+//     the query_by fields; the last token also matches as a prefix unless
+//     prefix=false. q=* matches every document.
+//   - filter_by supports clauses joined with &&: field:value, field:=value,
+//     field:!=value, field:>n, field:>=n, field:<n, field:<=n,
+//     field:[a,b] and field:[min..max].
+//   - sort_by supports up to three field:asc|desc clauses including
+//     _text_match.
+//
+// Synonyms are stored but not applied to searches, and API key scopes are not
+// enforced: any key created through the fake is accepted for every request.
+package typesensetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/aliml92/go-typesense/typesense"
+)
+
+// DefaultAPIKey is the bootstrap API key accepted by a new Server.
+const DefaultAPIKey = "xyz"
+
+// Server is a fake Typesense server listening on a local loopback address.
+type Server struct {
+	*httptest.Server
+
+	// APIKey is the bootstrap API key.
+	APIKey string
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	aliases     map[string]string
+	keys        []*typesense.ApiKey
+	nextKeyID   int64
+}
+
+// NewServer starts a fake server. Callers should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		APIKey:      DefaultAPIKey,
+		collections: make(map[string]*collection),
+		aliases:     make(map[string]string),
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Client returns a client configured for the server and its bootstrap key.
+func (s *Server) Client() *typesense.Client {
+	client, _ := typesense.NewClient(s.Server.Client(), s.URL, s.APIKey)
+	return client
+}
+
+// Reset drops every collection, alias and key.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collections = make(map[string]*collection)
+	s.aliases = make(map[string]string)
+	s.keys = nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] == "health" {
+		writeJSON(w, http.StatusOK, &typesense.HealthStatus{Ok: true})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "Forbidden - a valid `x-typesense-api-key` header must be sent.")
+		return
+	}
+
+	switch parts[0] {
+	case "collections":
+		s.serveCollections(w, r, parts[1:])
+	case "aliases":
+		s.serveAliases(w, r, parts[1:])
+	case "keys":
+		s.serveKeys(w, r, parts[1:])
+	case "multi_search":
+		s.serveMultiSearch(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	key := r.Header.Get("X-TYPESENSE-API-KEY")
+	if key == "" {
+		key = r.URL.Query().Get("x-typesense-api-key")
+	}
+	if key == s.APIKey {
+		return true
+	}
+	for _, k := range s.keys {
+		if k.Value != nil && *k.Value == key {
+			return true
+		}
+	}
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, &typesense.ApiResponse{Message: message})
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+}
+
+func decode(r *http.Request, v interface{}) error {
+	return json.NewDecoder(r.Body).Decode(v)
+}
@@ -0,0 +1,250 @@
+package typesensetest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aliml92/go-typesense/typesense"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) (*Server, *typesense.Client) {
+	s := NewServer()
+	t.Cleanup(s.Close)
+	client := s.Client()
+
+	_, err := client.Collections.Create(context.Background(), &typesense.CollectionSchema{
+		Name: "companies",
+		Fields: []*typesense.Field{
+			{Name: "company_name", Type: "string"},
+			{Name: "num_employees", Type: "int32"},
+			{Name: "country", Type: "string", Facet: typesense.Bool(true)},
+		},
+		DefaultSortingField: typesense.String("num_employees"),
+	})
+	require.NoError(t, err)
+
+	docs := []map[string]interface{}{
+		{"id": "1", "company_name": "Stark Industries", "num_employees": 5215, "country": "USA"},
+		{"id": "2", "company_name": "Orbit Inc.", "num_employees": 120, "country": "UK"},
+		{"id": "3", "company_name": "Starlight Labs", "num_employees": 48, "country": "USA"},
+	}
+	res, err := client.Documents.Import(context.Background(), "companies", docs, nil)
+	require.NoError(t, err)
+	for _, r := range res {
+		require.True(t, r.Success)
+	}
+	return s, client
+}
+
+func ids(res *typesense.SearchResult) []string {
+	var ids []string
+	for _, hit := range res.Hits {
+		ids = append(ids, hit.Document["id"].(string))
+	}
+	return ids
+}
+
+func TestServer_Collections(t *testing.T) {
+	ctx := context.Background()
+	_, client := setup(t)
+
+	c, err := client.Collections.Get(ctx, "companies")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), *c.NumDocuments)
+
+	_, err = client.Collections.Create(ctx, &typesense.CollectionSchema{Name: "companies"})
+	var apiErr *typesense.ApiError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+
+	list, err := client.Collections.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	_, err = client.Collections.Delete(ctx, "companies")
+	require.NoError(t, err)
+	_, err = client.Collections.Get(ctx, "companies")
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestServer_Documents(t *testing.T) {
+	ctx := context.Background()
+	_, client := setup(t)
+
+	_, err := client.Documents.Update(ctx, "companies", "2", map[string]interface{}{"num_employees": 150})
+	require.NoError(t, err)
+	doc, err := client.Documents.Get(ctx, "companies", "2")
+	require.NoError(t, err)
+	assert.Equal(t, float64(150), doc.(map[string]interface{})["num_employees"])
+
+	_, err = client.Documents.Create(ctx, "companies", map[string]interface{}{"company_name": "Acme"})
+	var apiErr *typesense.ApiError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+
+	updated, err := client.Documents.UpdateByQuery(ctx, "companies", map[string]interface{}{"country": "US"},
+		&typesense.UpdateOptions{FilterBy: "country:=USA"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.NumUpdated)
+
+	deleted, err := client.Documents.DeleteByQuery(ctx, "companies", &typesense.DeleteOptions{FilterBy: "num_employees:<100"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, *deleted.NumDeleted)
+
+	var buf bytes.Buffer
+	err = client.Documents.ExportJSONL(ctx, "companies", &buf, &typesense.ExportDocumentsParams{IncludeFields: "id,country"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"country":"US","id":"1"}`+"\n"+`{"country":"UK","id":"2"}`, strings.TrimSpace(buf.String()))
+}
+
+func TestServer_Search(t *testing.T) {
+	ctx := context.Background()
+	_, client := setup(t)
+
+	tests := []struct {
+		name   string
+		params *typesense.SearchParameters
+		want   []string
+	}{
+		{
+			name:   "prefix",
+			params: &typesense.SearchParameters{Q: "sta", QueryBy: "company_name"},
+			want:   []string{"1", "3"},
+		},
+		{
+			name:   "no prefix",
+			params: &typesense.SearchParameters{Q: "sta", QueryBy: "company_name", Prefix: typesense.String("false")},
+		},
+		{
+			name:   "filter",
+			params: &typesense.SearchParameters{Q: "*", FilterBy: typesense.String("country:USA && num_employees:>100")},
+			want:   []string{"1"},
+		},
+		{
+			name:   "range",
+			params: &typesense.SearchParameters{Q: "*", FilterBy: typesense.String("num_employees:[0..200]")},
+			want:   []string{"2", "3"},
+		},
+		{
+			name:   "sort",
+			params: &typesense.SearchParameters{Q: "*", SortBy: typesense.String("num_employees:asc")},
+			want:   []string{"3", "2", "1"},
+		},
+		{
+			name:   "page",
+			params: &typesense.SearchParameters{Q: "*", Page: typesense.Int(2), PerPage: typesense.Int(2)},
+			want:   []string{"3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := client.Documents.Search(ctx, "companies", tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(res))
+		})
+	}
+
+	_, err := client.Documents.Search(ctx, "companies", &typesense.SearchParameters{Q: "x", QueryBy: "missing"})
+	assert.Error(t, err)
+}
+
+func TestServer_MultiSearch(t *testing.T) {
+	ctx := context.Background()
+	_, client := setup(t)
+
+	res, err := client.Documents.MultiSearch(ctx, &typesense.MultiSearchSearchesParameter{
+		Searches: []typesense.MultiSearchCollectionParameters{
+			{Collection: "companies", Q: typesense.String("orbit"), QueryBy: typesense.String("company_name")},
+			{Collection: "missing", Q: typesense.String("*")},
+		},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 2)
+	assert.Equal(t, []string{"2"}, ids(res.Results[0].SearchResult))
+	assert.Equal(t, http.StatusNotFound, res.Results[1].SearchError.Code)
+}
+
+func TestServer_Aliases(t *testing.T) {
+	ctx := context.Background()
+	_, client := setup(t)
+
+	_, err := client.Aliases.Upsert(ctx, "firms", &typesense.CollectionAliasSchema{CollectionName: "companies"})
+	require.NoError(t, err)
+
+	res, err := client.Documents.Search(ctx, "firms", &typesense.SearchParameters{Q: "*"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, *res.Found)
+
+	aliases, err := client.Aliases.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+	assert.Equal(t, "companies", aliases[0].CollectionName)
+
+	_, err = client.Aliases.Upsert(ctx, "firms", &typesense.CollectionAliasSchema{CollectionName: "missing"})
+	assert.Error(t, err)
+}
+
+func TestServer_Keys(t *testing.T) {
+	ctx := context.Background()
+	s, client := setup(t)
+
+	key, err := client.Keys.Create(ctx, &typesense.ApiKeySchema{
+		Actions:     []typesense.KeyAction{"documents:search"},
+		Collections: []string{"companies"},
+		Description: typesense.String("search only"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, key.Value)
+
+	scoped, err := typesense.NewClient(nil, s.URL, *key.Value)
+	require.NoError(t, err)
+	_, err = scoped.Documents.Search(ctx, "companies", &typesense.SearchParameters{Q: "*"})
+	require.NoError(t, err)
+
+	keys, err := client.Keys.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys.Keys, 1)
+	assert.Nil(t, keys.Keys[0].Value)
+
+	_, err = client.Keys.Delete(ctx, int(*key.Id))
+	require.NoError(t, err)
+	_, err = scoped.Documents.Search(ctx, "companies", &typesense.SearchParameters{Q: "*"})
+	var apiErr *typesense.ApiError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
+
+func TestServer_Synonyms(t *testing.T) {
+	ctx := context.Background()
+	_, client := setup(t)
+
+	_, err := client.Synonyms.Upsert(ctx, "companies", "inc", &typesense.SearchSynonymSchema{
+		Synonyms: []string{"inc", "incorporated"},
+	})
+	require.NoError(t, err)
+
+	list, err := client.Synonyms.List(ctx, "companies")
+	require.NoError(t, err)
+	require.Len(t, list.Synonyms, 1)
+
+	_, err = client.Synonyms.Delete(ctx, "companies", "inc")
+	require.NoError(t, err)
+	_, err = client.Synonyms.Get(ctx, "companies", "inc")
+	assert.Error(t, err)
+}
+
+func TestServer_Reset(t *testing.T) {
+	s, client := setup(t)
+	s.Reset()
+
+	list, err := client.Collections.List(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}